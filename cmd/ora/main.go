@@ -4,37 +4,50 @@ package main
 
 import (
 	"bufio"
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"os/signal"
+	"slices"
 	"strings"
+	"syscall"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/wtg42/ora-ora-ora/internal/config"
+	"github.com/wtg42/ora-ora-ora/internal/editor"
+	"github.com/wtg42/ora-ora-ora/internal/export"
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+	"github.com/wtg42/ora-ora-ora/internal/importer"
+	"github.com/wtg42/ora-ora-ora/internal/listing"
 	"github.com/wtg42/ora-ora-ora/internal/note"
+	"github.com/wtg42/ora-ora-ora/internal/remind"
 	"github.com/wtg42/ora-ora-ora/internal/storage"
+	"github.com/wtg42/ora-ora-ora/internal/task"
 	"github.com/wtg42/ora-ora-ora/internal/tui"
 )
 
 // rootCmd 是整個 Ora 應用程式的基礎命令。
 // 所有的子命令都將註冊到此命令下。
+// AI 心智註解: 各命令的 Short、Long 與旗標說明放在 internal/i18n 的訊息目錄，由 localizeCommands 在執行前依語系設定。
 var rootCmd = &cobra.Command{
-	Use:   "ora",
-	Short: "Ora 是一個 AI 快速筆記應用程式",
-	Long:  `Ora 是一個用於快速建立和管理筆記的命令列應用程式，旨在與 AI CLI 代理互動。`,
+	Use:               "ora",
+	PersistentPreRunE: selectVault,
 	Run: func(cmd *cobra.Command, args []string) {
 		// 如果沒有給定子命令，則執行此處的預設行為。
-		fmt.Println("歡迎使用 Ora！使用 'ora --help' 獲取更多資訊。")
+		fmt.Println(i18n.T("cli.welcome"))
 	},
 }
 
 // noteCmd 是一個用於管理筆記的子命令。
 // 它包含了建立、查看和管理筆記的相關功能。
 var noteCmd = &cobra.Command{
-	Use:   "note",
-	Short: "管理您的筆記",
-	Long:  `提供用於建立、查看和管理筆記的命令。`,
+	Use: "note",
 	Run: func(cmd *cobra.Command, args []string) {
 		// 如果沒有給定 note 子命令，則顯示 note 命令的幫助資訊。
 		cmd.Help()
@@ -44,29 +57,27 @@ var noteCmd = &cobra.Command{
 // noteNewCmd 是一個用於建立新筆記的子命令。
 // 它會引導使用者輸入筆記標題、內容和可選標籤。
 var noteNewCmd = &cobra.Command{
-	Use:   "new",
-	Short: "建立一個新筆記",
-	Long:  `透過提示輸入標題、內容和可選標籤來互動式地建立一個新筆記。`,
+	Use: "new",
 	Run: func(cmd *cobra.Command, args []string) {
 		// 執行應用程式初始化，獲取配置和資料目錄。
 		configDir, dataDir, err := runApp()
 		if err != nil {
-			log.Fatalf("應用程式錯誤: %v", err)
+			log.Fatal(i18n.T("cli.app_error", err))
 		}
 
-		fmt.Printf("配置目錄: %s\n", configDir)
-		fmt.Printf("資料目錄: %s\n", dataDir)
+		fmt.Println(i18n.T("cli.config_dir", configDir))
+		fmt.Println(i18n.T("cli.data_dir", dataDir))
 
 		// 建立一個讀取器以從標準輸入讀取使用者輸入。
 		reader := bufio.NewReader(os.Stdin)
 
 		// 提示使用者輸入筆記標題。
-		fmt.Print("輸入筆記標題: ")
+		fmt.Print(i18n.T("cli.prompt_title"))
 		title, _ := reader.ReadString('\n')
 		title = strings.TrimSpace(title)
 
 		// 提示使用者輸入筆記內容，直到輸入兩次 Enter 為止。
-		fmt.Print("輸入筆記內容 (按兩次 Enter 結束):\n")
+		fmt.Println(i18n.T("cli.prompt_content"))
 		var contentBuilder strings.Builder
 		for {
 			line, _ := reader.ReadString('\n')
@@ -77,7 +88,7 @@ var noteNewCmd = &cobra.Command{
 		}
 		content := strings.TrimSpace(contentBuilder.String())
 
-		fmt.Print("輸入標籤 (逗號分隔，可選): ")
+		fmt.Print(i18n.T("cli.prompt_tags"))
 		tagsInput, _ := reader.ReadString('\n')
 		tagsInput = strings.TrimSpace(tagsInput)
 		var tags []string
@@ -91,31 +102,508 @@ var noteNewCmd = &cobra.Command{
 
 		// 建立一個新的筆記物件。
 		newNote := note.NewNote(title, content, tags)
-		fmt.Printf("\n新筆記已建立:\n")
-		fmt.Printf("標題: %s\n", newNote.Title)
-		fmt.Printf("內容: %s\n", newNote.Content)
-		fmt.Printf("標籤: %v\n", newNote.Tags)
-		fmt.Printf("建立時間: %s\n", newNote.CreatedAt.Format(time.RFC3339))
+		fmt.Printf("\n%s\n", i18n.T("cli.note_created"))
+		fmt.Println(i18n.T("cli.note_title", newNote.Title))
+		fmt.Println(i18n.T("cli.note_content", newNote.Content))
+		fmt.Println(i18n.T("cli.note_tags", newNote.Tags))
+		fmt.Println(i18n.T("cli.note_created_at", newNote.CreatedAt.Format(time.RFC3339)))
 
 		// 儲存新建立的筆記。
 		err = storage.SaveNote(newNote)
 		if err != nil {
-			log.Fatalf("儲存筆記失敗: %v", err)
+			log.Fatal(i18n.T("error.save_note", i18n.Error(err)))
 		}
-		fmt.Println("\n筆記已成功建立並儲存！")
+		fmt.Printf("\n%s\n", i18n.T("cli.note_saved"))
 	},
 }
 
+// noteEditCmd 是一個用於以外部編輯器編輯筆記的子命令。
+// 編輯器結束後會重新解析 front matter 並更新筆記的更新時間。
+var noteEditCmd = &cobra.Command{
+	Use:  "edit <id>",
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		path, err := storage.FindNotePath(args[0])
+		if err != nil {
+			log.Fatal(i18n.T("error.find_note", i18n.Error(err)))
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			log.Fatal(i18n.T("cli.read_config_failed", err))
+		}
+
+		// 建立編輯器指令並將標準輸入輸出交給編輯器。
+		editCmd, err := editor.Command(cfg.Editor, path)
+		if err != nil {
+			log.Fatal(i18n.T("cli.editor_command_failed", err))
+		}
+		editCmd.Stdin = os.Stdin
+		editCmd.Stdout = os.Stdout
+		editCmd.Stderr = os.Stderr
+		if err := editCmd.Run(); err != nil {
+			log.Fatal(i18n.T("error.editor", err))
+		}
+
+		// 重新解析並驗證編輯後的筆記。
+		n, err := storage.ReloadEditedNote(path)
+		if err != nil {
+			log.Fatal(i18n.T("cli.edited_note_invalid", i18n.Error(err)))
+		}
+		fmt.Println(i18n.T("cli.note_updated", n.Title, n.ID()))
+	},
+}
+
+// noteListCmd 列出所有筆記，可依旗標排序與分組。
+var noteListCmd = &cobra.Command{
+	Use:  "list",
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		sortFlag, _ := cmd.Flags().GetString("sort")
+		groupFlag, _ := cmd.Flags().GetString("group")
+		opts, err := parseListOptions(sortFlag, groupFlag)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		notes := loadAllNotes()
+		if len(notes) == 0 {
+			fmt.Println(i18n.T("cli.no_notes"))
+			return
+		}
+		// AI 心智註解: 只有依最近查看排序時才需要查看紀錄，其他排序不因紀錄檔損毀而失敗。
+		var views listing.Views
+		if opts.Key == listing.KeyViewed {
+			path, err := listing.DefaultViewsPath()
+			if err == nil {
+				views, err = listing.LoadViews(path)
+			}
+			if err != nil {
+				log.Fatal(i18n.T("error.load_views", err))
+			}
+		}
+		fmt.Print(formatNoteList(notes, opts, views, time.Now()))
+	},
+}
+
+// parseListOptions 解析 note list 的 --sort 與 --group 旗標。
+func parseListOptions(sortSpec, group string) (listing.Options, error) {
+	var opts listing.Options
+	var ok bool
+	if opts.Key, opts.Desc, ok = listing.ParseSort(sortSpec); !ok {
+		return opts, errors.New(i18n.T("cli.invalid_sort", sortSpec, joinValues(listing.Keys)))
+	}
+	if opts.Group, ok = listing.ParseGroup(group); !ok {
+		return opts, errors.New(i18n.T("cli.invalid_group", group, joinValues(listing.Groupings)))
+	}
+	return opts, nil
+}
+
+// joinValues 以逗號串接旗標的可用值。
+func joinValues[T ~string](values []T) string {
+	s := make([]string, len(values))
+	for i, v := range values {
+		s[i] = string(v)
+	}
+	return strings.Join(s, ", ")
+}
+
+// formatNoteList 將筆記依 opts 排序與分組，格式化為每則一行的日期、ID、標題與標籤。
+// 日期欄依排序鍵顯示建立、更新或查看時間，釘選的筆記在標題前加上 ★；分組時每組以組名開頭、組內縮排，組與組之間空一行。
+func formatNoteList(notes []*note.Note, opts listing.Options, views listing.Views, now time.Time) string {
+	var b strings.Builder
+	groups := listing.GroupNotes(listing.Sort(notes, opts, views), opts, views, now)
+	indent := ""
+	if opts.Group != listing.GroupNone {
+		indent = "  "
+	}
+	for i, g := range groups {
+		if opts.Group != listing.GroupNone {
+			if i > 0 {
+				b.WriteString("\n")
+			}
+			b.WriteString(i18n.GroupLabel(opts.Group, g.Name) + "\n")
+		}
+		for _, n := range g.Notes {
+			date := "-"
+			if t := listing.Date(n, opts.Key, views); !t.IsZero() {
+				date = t.Local().Format("2006-01-02")
+			}
+			title := n.Title
+			if n.Pinned {
+				title = "★ " + title
+			}
+			line := fmt.Sprintf("%s%-10s  %s  %s", indent, date, n.ID(), title)
+			for _, tag := range n.Tags {
+				line += " #" + tag
+			}
+			b.WriteString(line + "\n")
+		}
+	}
+	return b.String()
+}
+
+// notePinCmd 釘選筆記，釘選的筆記在 TUI 列表中固定顯示在最上方。
+var notePinCmd = &cobra.Command{
+	Use:  "pin <id>",
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		n := setPinned(args[0], true)
+		fmt.Println(i18n.T("cli.pinned", n.Title, n.ID()))
+	},
+}
+
+// noteUnpinCmd 取消釘選筆記。
+var noteUnpinCmd = &cobra.Command{
+	Use:  "unpin <id>",
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		n := setPinned(args[0], false)
+		fmt.Println(i18n.T("cli.unpinned", n.Title, n.ID()))
+	},
+}
+
+// setPinned 設定 ID 或標題為 ref 的筆記的釘選狀態，失敗時結束程式。
+func setPinned(ref string, pinned bool) *note.Note {
+	path, err := storage.FindNotePath(ref)
+	if err != nil {
+		log.Fatal(i18n.T("error.find_note", i18n.Error(err)))
+	}
+	n, err := storage.SetPinned(path, pinned)
+	if err != nil {
+		log.Fatal(i18n.T("error.pin", i18n.Error(err)))
+	}
+	return n
+}
+
+// taskCmd 是一個用於管理筆記中待辦項目的子命令。
+var taskCmd = &cobra.Command{
+	Use: "task",
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+// taskListCmd 列出所有筆記中的待辦項目。
+var taskListCmd = &cobra.Command{
+	Use: "list",
+	Run: func(cmd *cobra.Command, args []string) {
+		openOnly, _ := cmd.Flags().GetBool("open")
+		dueBefore, _ := cmd.Flags().GetString("due-before")
+
+		filter := task.Filter{OpenOnly: openOnly}
+		if dueBefore != "" {
+			due, err := time.ParseInLocation(task.DateLayout, dueBefore, time.Local)
+			if err != nil {
+				log.Fatal(i18n.T("cli.invalid_date", dueBefore, err))
+			}
+			filter.DueBefore = due
+		}
+
+		notes := loadAllNotes()
+		tasks := filter.Apply(task.Collect(notes))
+		if len(tasks) == 0 {
+			fmt.Println(i18n.T("cli.no_tasks"))
+			return
+		}
+		for _, t := range tasks {
+			fmt.Println(formatTask(t))
+		}
+	},
+}
+
+// taskDoneCmd 切換指定待辦項目的勾選狀態，並寫回來源筆記檔案。
+var taskDoneCmd = &cobra.Command{
+	Use:  "done <ref>",
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		file, line, checksum, err := task.ParseRef(args[0])
+		if err != nil {
+			log.Fatal(i18n.Error(err))
+		}
+		path, err := storage.ResolveNoteFile(file)
+		if err != nil {
+			log.Fatal(i18n.T("error.find_note", i18n.Error(err)))
+		}
+		n, err := storage.LoadNote(path)
+		if err != nil {
+			log.Fatal(i18n.T("error.read_note", i18n.Error(err)))
+		}
+
+		content, done, err := task.Toggle(n.Content, line, checksum)
+		if err != nil {
+			log.Fatal(i18n.T("error.toggle_task", i18n.Error(err)))
+		}
+		n.Content = content
+		if err := storage.UpdateNote(n); err != nil {
+			log.Fatal(i18n.T("error.save_note", i18n.Error(err)))
+		}
+
+		if done {
+			fmt.Println(i18n.T("cli.task_done", args[0]))
+		} else {
+			fmt.Println(i18n.T("cli.task_reopened", args[0]))
+		}
+	},
+}
+
+// formatTask 將待辦項目格式化為單行輸出。
+func formatTask(t task.Task) string {
+	box := "[ ]"
+	if t.Done {
+		box = "[x]"
+	}
+	parts := []string{box, t.Ref(), t.Text}
+	if !t.Due.IsZero() {
+		parts = append(parts, "due:"+t.Due.Format(task.DateLayout))
+	}
+	for _, p := range t.People {
+		parts = append(parts, "@"+p)
+	}
+	if t.Priority != task.PriorityNone {
+		parts = append(parts, t.Priority.String())
+	}
+	return fmt.Sprintf("%s  (%s)", strings.Join(parts, " "), t.NoteTitle)
+}
+
+// remindCmd 是一個用於管理提醒的子命令。
+var remindCmd = &cobra.Command{
+	Use: "remind",
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+// remindListCmd 列出所有提醒及其排定時間。
+var remindListCmd = &cobra.Command{
+	Use: "list",
+	Run: func(cmd *cobra.Command, args []string) {
+		notes := loadAllNotes()
+		reminders := remind.Collect(notes)
+		if len(reminders) == 0 {
+			fmt.Println(i18n.T("cli.no_reminders"))
+			return
+		}
+		for _, r := range reminders {
+			fmt.Printf("%s  %s  %s\n", r.At.Local().Format("2006-01-02 15:04"), r.ID, formatReminder(r))
+		}
+	},
+}
+
+// formatReminder 以目前語系返回提醒顯示的文字：筆記的到期提醒加上「到期」，待辦項目附上所屬筆記的標題。
+func formatReminder(r remind.Reminder) string {
+	switch r.Kind {
+	case remind.KindDue:
+		return i18n.T("remind.due_title", r.Title)
+	case remind.KindTask:
+		return i18n.T("remind.task_title", r.Title, r.Note)
+	}
+	return r.Title
+}
+
+// remindDaemonCmd 啟動提醒常駐程序，直到收到中斷訊號為止。
+var remindDaemonCmd = &cobra.Command{
+	Use: "daemon",
+	Run: func(cmd *cobra.Command, args []string) {
+		interval, _ := cmd.Flags().GetDuration("interval")
+		hook, _ := cmd.Flags().GetString("hook")
+		logPath, _ := cmd.Flags().GetString("log")
+		quiet, _ := cmd.Flags().GetBool("quiet")
+
+		statePath, err := remind.DefaultStatePath()
+		if err != nil {
+			log.Fatal(i18n.Error(err))
+		}
+
+		// 依旗標組合通知方式。
+		var notifiers []remind.Notifier
+		if !quiet {
+			notifiers = append(notifiers, remind.WriterNotifier{W: os.Stdout})
+		}
+		if hook != "" {
+			notifiers = append(notifiers, remind.HookNotifier{Command: hook})
+		}
+		if logPath != "" {
+			notifiers = append(notifiers, remind.LogFileNotifier{Path: logPath})
+		}
+		if len(notifiers) == 0 {
+			log.Fatal(i18n.T("cli.no_notifier"))
+		}
+
+		d := remind.NewDaemon(statePath, notifiers...)
+		d.Interval = interval
+		d.Format = formatReminder
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		err = d.Run(ctx, func(err error) {
+			fmt.Fprintln(os.Stderr, i18n.T("cli.remind_check_failed", i18n.Error(err)))
+		})
+		if err != nil && !errors.Is(err, context.Canceled) {
+			log.Fatal(i18n.T("cli.daemon_failed", err))
+		}
+	},
+}
+
+// remindSnoozeCmd 將指定提醒延後一段時間。
+var remindSnoozeCmd = &cobra.Command{
+	Use:  "snooze <id> <duration>",
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		d, err := time.ParseDuration(args[1])
+		if err != nil {
+			log.Fatal(i18n.T("cli.invalid_duration", args[1], err))
+		}
+		statePath, err := remind.DefaultStatePath()
+		if err != nil {
+			log.Fatal(i18n.Error(err))
+		}
+		until, err := remind.Snooze(statePath, remind.Collect(loadAllNotes()), args[0], d, remind.RealClock())
+		if err != nil {
+			log.Fatal(i18n.T("cli.snooze_failed", i18n.Error(err)))
+		}
+		fmt.Println(i18n.T("cli.snoozed", args[0], until.Format("2006-01-02 15:04")))
+	},
+}
+
+// importCmd 從其他筆記工具匯入筆記。
+var importCmd = &cobra.Command{
+	Use:       "import obsidian|joplin|markdown <path>",
+	Args:      cobra.ExactArgs(2),
+	ValidArgs: []string{string(importer.FormatObsidian), string(importer.FormatJoplin), string(importer.FormatMarkdown)},
+	Run: func(cmd *cobra.Command, args []string) {
+		rep, err := importer.Import(importer.Format(args[0]), args[1])
+		if err != nil {
+			log.Fatal(i18n.T("cli.import_failed", i18n.Error(err)))
+		}
+		printImportReport(rep)
+	},
+}
+
+// printImportReport 輸出匯入結果與無法轉換的項目。
+func printImportReport(rep *importer.Report) {
+	fmt.Println(i18n.T("cli.imported", len(rep.Imported)))
+	if len(rep.Skipped) > 0 {
+		fmt.Println(i18n.T("cli.import_skipped", len(rep.Skipped)))
+	}
+	if len(rep.Problems) > 0 {
+		fmt.Printf("\n%s\n", i18n.T("cli.import_problems", len(rep.Problems)))
+		for _, p := range rep.Problems {
+			fmt.Printf("  - %s\n", p)
+		}
+	}
+}
+
+// exportCmd 將筆記匯出為其他格式。
+var exportCmd = &cobra.Command{
+	Use: "export",
+	Run: func(cmd *cobra.Command, args []string) {
+		format, _ := cmd.Flags().GetString("format")
+		output, _ := cmd.Flags().GetString("output")
+		tags, _ := cmd.Flags().GetStringSlice("tag")
+		after, _ := cmd.Flags().GetString("after")
+		before, _ := cmd.Flags().GetString("before")
+		folder, _ := cmd.Flags().GetString("folder")
+		if !slices.Contains(export.Formats, export.Format(format)) {
+			log.Fatal(i18n.T("cli.unsupported_format", format, export.Formats))
+		}
+
+		filter := export.Filter{Tags: tags, Folder: folder}
+		var err error
+		if filter.After, err = parseDateFlag(after); err != nil {
+			log.Fatal(i18n.T("cli.invalid_flag", "--after", err))
+		}
+		if filter.Before, err = parseDateFlag(before); err != nil {
+			log.Fatal(i18n.T("cli.invalid_flag", "--before", err))
+		}
+
+		all := loadAllNotes()
+		notes := filter.Apply(all)
+
+		if export.Format(format) == export.FormatHTML {
+			if output == "" {
+				log.Fatal(i18n.T("cli.html_output_required"))
+			}
+			if err := export.WriteHTML(output, notes); err != nil {
+				log.Fatal(i18n.T("cli.export_failed", i18n.Error(err)))
+			}
+			fmt.Fprintln(os.Stderr, i18n.T("cli.exported", len(notes), output))
+			return
+		}
+
+		// 其他格式預設輸出至 stdout，指定 --output 時寫入檔案。
+		if output == "" {
+			err = writeExport(os.Stdout, export.Format(format), notes)
+		} else {
+			f, createErr := os.Create(output)
+			if createErr != nil {
+				log.Fatal(i18n.T("cli.create_output_failed", createErr))
+			}
+			// AI 心智註解: 明確關閉並檢查錯誤；關閉失敗代表檔案可能不完整，不能回報成功。
+			err = writeExport(f, export.Format(format), notes)
+			if closeErr := f.Close(); err == nil {
+				err = closeErr
+			}
+		}
+		if err != nil {
+			log.Fatal(i18n.T("cli.export_failed", i18n.Error(err)))
+		}
+	},
+}
+
+// writeExport 以 JSON、JSONL 或 Markdown 合集格式將筆記寫入 w。
+func writeExport(w io.Writer, format export.Format, notes []*note.Note) error {
+	switch format {
+	case export.FormatJSON:
+		return export.WriteJSON(w, notes)
+	case export.FormatJSONL:
+		return export.WriteJSONL(w, notes)
+	case export.FormatMDBundle:
+		return export.WriteBundle(w, notes)
+	}
+	return nil
+}
+
+// parseDateFlag 解析 YYYY-MM-DD 格式的日期旗標，空字串返回零值。
+func parseDateFlag(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.ParseInLocation("2006-01-02", value, time.Local)
+}
+
+// selectVault 依 --vault 旗標或配置檔案的 vault 選用筆記庫，兩者都未設定時使用預設的資料目錄。
+func selectVault(cmd *cobra.Command, args []string) error {
+	// AI 心智註解: 筆記庫設定錯誤與命令用法無關，不印出用法；錯誤訊息由 main 統一印出。
+	cmd.SilenceUsage, cmd.SilenceErrors = true, true
+	name, _ := cmd.Flags().GetString("vault")
+	cfg, err := config.Load()
+	if err != nil {
+		// AI 心智註解: 沒有指定筆記庫時配置錯誤交由讀取配置的命令或 TUI 回報，不阻擋其他命令。
+		if name != "" {
+			return fmt.Errorf(i18n.T("cli.read_config_failed"), err)
+		}
+		return nil
+	}
+	if name == "" {
+		name = cfg.Vault
+	}
+	dir, ok := cfg.Vaults.Dir(name)
+	if !ok {
+		return errors.New(i18n.T("cli.unknown_vault", name))
+	}
+	storage.SetVault(name, dir)
+	return nil
+}
+
 // tuiCmd 是一個用於啟動 TUI 介面的子命令。
 // 它使用 BubbleTea 框架來提供互動式終端使用者介面。
 var tuiCmd = &cobra.Command{
-	Use:   "tui",
-	Short: "啟動 TUI 介面",
-	Long:  `啟動互動式終端使用者介面來管理筆記。`,
+	Use: "tui",
 	Run: func(cmd *cobra.Command, args []string) {
 		p := tea.NewProgram(tui.InitialModel())
 		if _, err := p.Run(); err != nil {
-			fmt.Fprintf(os.Stderr, "TUI 錯誤: %v\n", err)
+			fmt.Fprintln(os.Stderr, i18n.T("cli.tui_error", err))
 			os.Exit(1)
 		}
 	},
@@ -127,13 +615,13 @@ func runApp() (string, string, error) {
 	// 獲取配置目錄。
 	configDir, err := storage.GetConfigDir()
 	if err != nil {
-		return "", "", fmt.Errorf("獲取配置目錄失敗: %w", err)
+		return "", "", fmt.Errorf(i18n.T("cli.config_dir_failed"), err)
 	}
 
 	// 獲取資料目錄。
 	dataDir, err := storage.GetDataDir()
 	if err != nil {
-		return "", "", fmt.Errorf("獲取資料目錄失敗: %w", err)
+		return "", "", fmt.Errorf(i18n.T("cli.data_dir_failed"), err)
 	}
 
 	return configDir, dataDir, nil
@@ -141,19 +629,102 @@ func runApp() (string, string, error) {
 
 // init 函數在 main 函數執行前被呼叫，用於初始化 Cobra 命令。
 func init() {
+	rootCmd.PersistentFlags().String("vault", "", "")
 	// 將 noteCmd 添加為 rootCmd 的子命令。
 	rootCmd.AddCommand(noteCmd)
 	// 將 noteNewCmd 添加為 noteCmd 的子命令。
 	noteCmd.AddCommand(noteNewCmd)
+	// 將 noteEditCmd 添加為 noteCmd 的子命令。
+	noteCmd.AddCommand(noteEditCmd)
+	// 將 noteListCmd 添加為 noteCmd 的子命令。
+	noteListCmd.Flags().String("sort", string(listing.KeyCreated)+":asc", "")
+	noteListCmd.Flags().String("group", string(listing.GroupNone), "")
+	noteCmd.AddCommand(noteListCmd)
+	// 將 notePinCmd 與 noteUnpinCmd 添加為 noteCmd 的子命令。
+	noteCmd.AddCommand(notePinCmd)
+	noteCmd.AddCommand(noteUnpinCmd)
+	// 將 taskCmd 及其子命令添加到 rootCmd。
+	rootCmd.AddCommand(taskCmd)
+	taskListCmd.Flags().Bool("open", false, "")
+	taskListCmd.Flags().String("due-before", "", "")
+	taskCmd.AddCommand(taskListCmd)
+	taskCmd.AddCommand(taskDoneCmd)
+	// 將 remindCmd 及其子命令添加到 rootCmd。
+	rootCmd.AddCommand(remindCmd)
+	remindDaemonCmd.Flags().Duration("interval", remind.DefaultInterval, "")
+	remindDaemonCmd.Flags().String("hook", "", "")
+	remindDaemonCmd.Flags().String("log", "", "")
+	remindDaemonCmd.Flags().Bool("quiet", false, "")
+	remindCmd.AddCommand(remindListCmd)
+	remindCmd.AddCommand(remindDaemonCmd)
+	remindCmd.AddCommand(remindSnoozeCmd)
+	// 將 importCmd 添加為 rootCmd 的子命令。
+	rootCmd.AddCommand(importCmd)
+	// 將 exportCmd 添加為 rootCmd 的子命令。
+	rootCmd.AddCommand(exportCmd)
+	exportCmd.Flags().StringP("format", "f", string(export.FormatJSON), "")
+	exportCmd.Flags().StringP("output", "o", "", "")
+	exportCmd.Flags().StringSlice("tag", nil, "")
+	exportCmd.Flags().String("after", "", "")
+	exportCmd.Flags().String("before", "", "")
+	exportCmd.Flags().String("folder", "", "")
 	// 將 tuiCmd 添加為 rootCmd 的子命令。
 	rootCmd.AddCommand(tuiCmd)
 }
 
+// loadAllNotes 載入所有筆記；略過無法讀取的筆記時以警告印出其錯誤並繼續，其他錯誤則結束程式。
+func loadAllNotes() []*note.Note {
+	notes, err := storage.LoadAllNotes()
+	var skipped *storage.SkippedNotesError
+	if errors.As(err, &skipped) {
+		for _, e := range skipped.Errs {
+			fmt.Fprintln(os.Stderr, i18n.T("cli.warning", i18n.Error(e)))
+		}
+	} else if err != nil {
+		log.Fatal(i18n.T("error.load_notes", i18n.Error(err)))
+	}
+	return notes
+}
+
+// setupLocale 依配置檔案與環境變數設定介面語系，並以該語系設定命令與旗標的說明。
+func setupLocale() {
+	// AI 心智註解: 配置檔案有誤時仍依環境變數判斷語系，配置錯誤由讀取配置的命令或 TUI 回報。
+	cfg, _ := config.Load()
+	locale, err := i18n.Detect(cfg.Locale)
+	i18n.Set(locale)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("cli.warning", err))
+	}
+	localizeCommands(rootCmd)
+}
+
+// localizeCommands 設定命令及其子命令的說明與旗標說明。
+// 訊息鍵由命令路徑組成，例如 cmd.ora.task.list.short 與 cmd.ora.task.list.flag.open；
+// 目錄中沒有的鍵（例如 Cobra 自動加入的 help 命令）保留原本的說明。
+func localizeCommands(cmd *cobra.Command) {
+	prefix := "cmd." + strings.ReplaceAll(cmd.CommandPath(), " ", ".")
+	if s, ok := i18n.Lookup(prefix + ".short"); ok {
+		cmd.Short = s
+	}
+	if s, ok := i18n.Lookup(prefix + ".long"); ok {
+		cmd.Long = s
+	}
+	cmd.LocalFlags().VisitAll(func(f *pflag.Flag) {
+		if s, ok := i18n.Lookup(prefix + ".flag." + f.Name); ok {
+			f.Usage = s
+		}
+	})
+	for _, sub := range cmd.Commands() {
+		localizeCommands(sub)
+	}
+}
+
 // main 函數是應用程式的入口點。
 func main() {
+	setupLocale()
 	// 執行 rootCmd，解析命令列參數並執行對應的命令。
 	if err := rootCmd.Execute(); err != nil {
-		fmt.Fprintf(os.Stderr, "錯誤: %v\n", err)
+		fmt.Fprintln(os.Stderr, i18n.T("cli.error", err))
 		os.Exit(1)
 	}
 }
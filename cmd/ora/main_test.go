@@ -3,8 +3,16 @@ package main
 
 import (
 	"testing"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+	"github.com/wtg42/ora-ora-ora/internal/listing"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+	"github.com/wtg42/ora-ora-ora/internal/remind"
+	"github.com/wtg42/ora-ora-ora/internal/task"
 	"github.com/wtg42/ora-ora-ora/internal/tui"
 )
 
@@ -39,3 +47,130 @@ func TestTUIBuild(t *testing.T) {
 		t.Error("NewProgram() 返回 nil")
 	}
 }
+
+// TestFormatTask 測試待辦項目的單行輸出格式。
+func TestFormatTask(t *testing.T) {
+	due := time.Date(2026, 10, 20, 0, 0, 0, 0, time.Local)
+	tk := task.Task{
+		NoteID:    "20261001090000",
+		NoteTitle: "週會",
+		NoteFile:  "work/20261001090000-週會.md",
+		Line:      2,
+		Raw:       "準備簡報 due:2026-10-20 @alice !high",
+		Text:      "準備簡報",
+		Due:       due,
+		People:    []string{"alice"},
+		Priority:  task.PriorityHigh,
+	}
+
+	expected := "[ ] work/20261001090000-週會.md:2:" + task.Checksum(tk.Raw) + " 準備簡報 due:2026-10-20 @alice !high  (週會)"
+	if got := formatTask(tk); got != expected {
+		t.Errorf("預期 %q, 實際得到 %q", expected, got)
+	}
+}
+
+// TestParseListOptions 測試 note list 的排序與分組旗標。
+func TestParseListOptions(t *testing.T) {
+	opts, err := parseListOptions("updated", "tag")
+	if err != nil {
+		t.Fatalf("parseListOptions() 返回錯誤: %v", err)
+	}
+	expected := listing.Options{Key: listing.KeyUpdated, Desc: true, Group: listing.GroupTag}
+	if opts != expected {
+		t.Errorf("預期 %+v, 實際得到 %+v", expected, opts)
+	}
+	if _, err := parseListOptions("name", "none"); err == nil {
+		t.Error("無效的排序應返回錯誤")
+	}
+	if _, err := parseListOptions("title", "month"); err == nil {
+		t.Error("無效的分組應返回錯誤")
+	}
+}
+
+// TestFormatNoteList 測試 note list 依標籤分組的輸出格式。
+func TestFormatNoteList(t *testing.T) {
+	old := i18n.Current()
+	i18n.Set(i18n.ZhTW)
+	t.Cleanup(func() { i18n.Set(old) })
+
+	notes := []*note.Note{
+		{Title: "週報", Tags: []string{"work"}, CreatedAt: time.Date(2026, 10, 1, 9, 0, 0, 0, time.Local)},
+		{Title: "購物", Pinned: true, CreatedAt: time.Date(2026, 10, 2, 9, 0, 0, 0, time.Local), UpdatedAt: time.Date(2026, 10, 5, 9, 0, 0, 0, time.Local)},
+	}
+	opts := listing.Options{Key: listing.KeyUpdated, Desc: true, Group: listing.GroupTag}
+	expected := "#work\n" +
+		"  2026-10-01  20261001090000  週報 #work\n" +
+		"\n" +
+		"未加標籤\n" +
+		"  2026-10-05  20261002090000  ★ 購物\n"
+	if got := formatNoteList(notes, opts, nil, time.Now()); got != expected {
+		t.Errorf("預期 %q, 實際得到 %q", expected, got)
+	}
+
+	expected = "2026-10-01  20261001090000  週報 #work\n" +
+		"2026-10-02  20261002090000  ★ 購物\n"
+	if got := formatNoteList(notes, listing.Default(), nil, time.Now()); got != expected {
+		t.Errorf("預期 %q, 實際得到 %q", expected, got)
+	}
+}
+
+// TestLocalizeCommands 測試每個語系都為所有命令與旗標提供說明。
+func TestLocalizeCommands(t *testing.T) {
+	old := i18n.Current()
+	t.Cleanup(func() {
+		i18n.Set(old)
+		localizeCommands(rootCmd)
+	})
+
+	for _, l := range i18n.Locales() {
+		i18n.Set(l)
+		localizeCommands(rootCmd)
+		var check func(cmd *cobra.Command)
+		check = func(cmd *cobra.Command) {
+			if cmd.Short == "" {
+				t.Errorf("%s: %s 沒有簡短說明", l, cmd.CommandPath())
+			}
+			cmd.LocalFlags().VisitAll(func(f *pflag.Flag) {
+				if f.Usage == "" {
+					t.Errorf("%s: %s 的旗標 --%s 沒有說明", l, cmd.CommandPath(), f.Name)
+				}
+			})
+			for _, sub := range cmd.Commands() {
+				check(sub)
+			}
+		}
+		check(rootCmd)
+	}
+
+	i18n.Set(i18n.En)
+	localizeCommands(rootCmd)
+	if rootCmd.Short != "Ora is a quick note-taking app for AI workflows" {
+		t.Errorf("英文語系的說明未套用: %q", rootCmd.Short)
+	}
+}
+
+// TestFormatReminder 測試提醒依來源與語系顯示的文字。
+func TestFormatReminder(t *testing.T) {
+	old := i18n.Current()
+	t.Cleanup(func() { i18n.Set(old) })
+
+	tests := []struct {
+		r    remind.Reminder
+		zhTW string
+		en   string
+	}{
+		{remind.Reminder{Kind: remind.KindRemind, Title: "牙醫"}, "牙醫", "牙醫"},
+		{remind.Reminder{Kind: remind.KindDue, Title: "專案"}, "專案（到期）", "專案 (due)"},
+		{remind.Reminder{Kind: remind.KindTask, Title: "交報告", Note: "專案"}, "交報告（專案）", "交報告 (專案)"},
+	}
+	for _, tt := range tests {
+		i18n.Set(i18n.ZhTW)
+		if got := formatReminder(tt.r); got != tt.zhTW {
+			t.Errorf("預期 %q, 實際得到 %q", tt.zhTW, got)
+		}
+		i18n.Set(i18n.En)
+		if got := formatReminder(tt.r); got != tt.en {
+			t.Errorf("預期 %q, 實際得到 %q", tt.en, got)
+		}
+	}
+}
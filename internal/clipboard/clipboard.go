@@ -0,0 +1,146 @@
+// Package clipboard 負責系統剪貼簿的複製與貼上。
+// 複製時使用 OSC 52 終端控制序列，由終端機寫入剪貼簿，透過 SSH 連線時也能複製到本機；
+// 本機有 pbcopy、wl-copy、xclip 等剪貼簿工具時可同時使用，貼上則只能透過這些工具。
+package clipboard
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// Mode 決定複製與貼上使用的方式，對應配置檔案中的 clipboard。
+type Mode string
+
+const (
+	ModeAuto  Mode = "auto"  // 以 OSC 52 複製，本機有剪貼簿工具且不是透過 SSH 連線時同時使用工具；貼上使用工具（預設）。
+	ModeOSC52 Mode = "osc52" // 只使用 OSC 52，不執行任何外部程式，因此無法貼上。
+	ModeLocal Mode = "local" // 只使用本機的剪貼簿工具，適合不支援 OSC 52 的終端機。
+)
+
+// ErrNoTool 表示找不到可用的本機剪貼簿工具。
+var ErrNoTool = errors.New("no clipboard tool found (pbcopy, wl-copy, xclip or xsel)")
+
+// ErrPasteUnsupported 表示目前的模式不支援貼上。
+var ErrPasteUnsupported = errors.New("pasting requires a local clipboard tool, which the osc52 mode does not use")
+
+// ParseMode 解析配置檔案中的 clipboard，空字串為 ModeAuto。
+func ParseMode(s string) (Mode, error) {
+	switch m := Mode(s); m {
+	case "":
+		return ModeAuto, nil
+	case ModeAuto, ModeOSC52, ModeLocal:
+		return m, nil
+	}
+	return ModeAuto, fmt.Errorf("unknown clipboard mode %q", s)
+}
+
+// Sequence 返回將文字寫入剪貼簿的 OSC 52 控制序列。
+// tmux 為 true 時以 tmux 的 DCS passthrough 包裝，讓 tmux 把序列轉交給外層的終端機。
+func Sequence(text string, tmux bool) string {
+	seq := "\x1b]52;c;" + base64.StdEncoding.EncodeToString([]byte(text)) + "\x07"
+	if tmux {
+		// AI 心智註解: passthrough 內的 ESC 必須重複一次，tmux 需開啟 allow-passthrough 或 set-clipboard 才會轉交。
+		seq = "\x1bPtmux;" + strings.ReplaceAll(seq, "\x1b", "\x1b\x1b") + "\x1b\\"
+	}
+	return seq
+}
+
+// TerminalSequence 返回文字的 OSC 52 控制序列；在 tmux 中執行時自動包裝為 passthrough。
+// AI 心智註解: 序列必須經由 TUI 程式本身的輸出寫出，直接寫入 /dev/tty 會與畫面渲染同時寫入而打亂畫面。
+func TerminalSequence(text string) string {
+	return Sequence(text, os.Getenv("TMUX") != "")
+}
+
+// UsesOSC52 判斷模式是否以 OSC 52 複製；呼叫端需自行寫出 TerminalSequence 的序列。
+func UsesOSC52(mode Mode) bool {
+	return mode != ModeLocal
+}
+
+// tool 是本機剪貼簿工具複製與貼上的指令。
+type tool struct {
+	copy  []string
+	paste []string
+}
+
+// localTool 依作業系統與顯示環境返回第一個存在的剪貼簿工具。
+func localTool() (tool, bool) {
+	var candidates []tool
+	switch runtime.GOOS {
+	case "darwin":
+		candidates = append(candidates, tool{copy: []string{"pbcopy"}, paste: []string{"pbpaste"}})
+	case "windows":
+		candidates = append(candidates, tool{copy: []string{"clip.exe"}, paste: []string{"powershell.exe", "-NoProfile", "-Command", "Get-Clipboard"}})
+	}
+	if os.Getenv("WAYLAND_DISPLAY") != "" {
+		candidates = append(candidates, tool{copy: []string{"wl-copy"}, paste: []string{"wl-paste", "--no-newline"}})
+	}
+	if os.Getenv("DISPLAY") != "" {
+		candidates = append(candidates,
+			tool{copy: []string{"xclip", "-selection", "clipboard"}, paste: []string{"xclip", "-selection", "clipboard", "-o"}},
+			tool{copy: []string{"xsel", "--clipboard", "--input"}, paste: []string{"xsel", "--clipboard", "--output"}})
+	}
+	for _, t := range candidates {
+		if _, err := exec.LookPath(t.copy[0]); err == nil {
+			return t, true
+		}
+	}
+	return tool{}, false
+}
+
+// remote 判斷是否透過 SSH 連線執行；此時本機工具寫入的是遠端主機的剪貼簿。
+func remote() bool {
+	return os.Getenv("SSH_TTY") != "" || os.Getenv("SSH_CONNECTION") != ""
+}
+
+// Copy 依模式以本機剪貼簿工具複製文字；OSC 52 的部分由呼叫端寫出序列，見 UsesOSC52。
+// ModeOSC52 不執行任何工具；ModeAuto 在沒有工具或透過 SSH 連線時也不執行，
+// 工具失敗時仍有 OSC 52 複製，因此不返回錯誤；ModeLocal 沒有工具時返回 ErrNoTool。
+func Copy(mode Mode, text string) error {
+	if mode == ModeOSC52 {
+		return nil
+	}
+	t, found := localTool()
+	if mode == ModeLocal {
+		if !found {
+			return ErrNoTool
+		}
+		return runCopy(t, text)
+	}
+	if !found || remote() {
+		return nil
+	}
+	_ = runCopy(t, text)
+	return nil
+}
+
+// runCopy 以剪貼簿工具複製文字。
+func runCopy(t tool, text string) error {
+	cmd := exec.Command(t.copy[0], t.copy[1:]...)
+	cmd.Stdin = strings.NewReader(text)
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("run %s: %w", t.copy[0], err)
+	}
+	return nil
+}
+
+// Paste 以本機的剪貼簿工具讀取剪貼簿中的文字；ModeOSC52 不執行外部程式，返回 ErrPasteUnsupported。
+// AI 心智註解: OSC 52 的讀取需等待終端機回應並從輸入中取出，多數終端機也預設停用，因此貼上只透過本機工具。
+func Paste(mode Mode) (string, error) {
+	if mode == ModeOSC52 {
+		return "", ErrPasteUnsupported
+	}
+	t, found := localTool()
+	if !found {
+		return "", ErrNoTool
+	}
+	out, err := exec.Command(t.paste[0], t.paste[1:]...).Output()
+	if err != nil {
+		return "", fmt.Errorf("run %s: %w", t.paste[0], err)
+	}
+	return string(out), nil
+}
@@ -0,0 +1,104 @@
+// Package clipboard 提供了剪貼簿功能的單元測試。
+package clipboard
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSequence 測試 OSC 52 控制序列與 tmux passthrough 的包裝。
+func TestSequence(t *testing.T) {
+	assert.Equal(t, "\x1b]52;c;5L2g5aW9\x07", Sequence("你好", false))
+	assert.Equal(t, "\x1bPtmux;\x1b\x1b]52;c;5L2g5aW9\x07\x1b\\", Sequence("你好", true))
+}
+
+// TestTerminalSequence 測試依 $TMUX 決定是否包裝控制序列，以及各模式是否使用 OSC 52。
+func TestTerminalSequence(t *testing.T) {
+	t.Setenv("TMUX", "")
+	assert.Equal(t, "\x1b]52;c;aGk=\x07", TerminalSequence("hi"))
+	t.Setenv("TMUX", "/tmp/tmux-0/default,1,0")
+	assert.Equal(t, Sequence("hi", true), TerminalSequence("hi"))
+
+	assert.True(t, UsesOSC52(ModeAuto))
+	assert.True(t, UsesOSC52(ModeOSC52))
+	assert.False(t, UsesOSC52(ModeLocal))
+}
+
+// TestParseMode 測試配置檔案中 clipboard 的解析。
+func TestParseMode(t *testing.T) {
+	for _, s := range []string{"", "auto"} {
+		mode, err := ParseMode(s)
+		require.NoError(t, err)
+		assert.Equal(t, ModeAuto, mode)
+	}
+	mode, err := ParseMode("osc52")
+	require.NoError(t, err)
+	assert.Equal(t, ModeOSC52, mode)
+	_, err = ParseMode("x11")
+	assert.Error(t, err)
+}
+
+// fakeEnv 以假的 xclip 取代本機剪貼簿工具，返回工具保存剪貼簿內容的檔案。
+func fakeEnv(t *testing.T, withTool bool) (clip string) {
+	t.Helper()
+	dir := t.TempDir()
+	clip = filepath.Join(dir, "clip.txt")
+	path := dir
+	if withTool {
+		script := "#!/bin/sh\nfor a in \"$@\"; do [ \"$a\" = -o ] && exec cat " + clip + "; done\ncat > " + clip + "\n"
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "xclip"), []byte(script), 0755))
+		// AI 心智註解: 假的 xclip 需要 cat；沒有工具的情境只搜尋暫存目錄，避免找到系統上真的剪貼簿工具。
+		path += string(os.PathListSeparator) + "/usr/bin:/bin"
+	}
+	t.Setenv("PATH", path)
+	t.Setenv("DISPLAY", ":0")
+	t.Setenv("WAYLAND_DISPLAY", "")
+	t.Setenv("SSH_TTY", "")
+	t.Setenv("SSH_CONNECTION", "")
+	return clip
+}
+
+// TestCopyAndPaste_LocalTool 測試各模式是否執行本機工具，以及以工具貼上。
+func TestCopyAndPaste_LocalTool(t *testing.T) {
+	clip := fakeEnv(t, true)
+	clipped := func() string {
+		data, err := os.ReadFile(clip)
+		require.NoError(t, err)
+		return string(data)
+	}
+
+	require.NoError(t, Copy(ModeAuto, "第一段"))
+	text, err := Paste(ModeAuto)
+	require.NoError(t, err)
+	assert.Equal(t, "第一段", text)
+
+	require.NoError(t, Copy(ModeLocal, "第二段"))
+	text, err = Paste(ModeLocal)
+	require.NoError(t, err)
+	assert.Equal(t, "第二段", text)
+
+	// 只使用 OSC 52 時不執行工具。
+	require.NoError(t, Copy(ModeOSC52, "第三段"))
+	assert.Equal(t, "第二段", clipped())
+	_, err = Paste(ModeOSC52)
+	assert.ErrorIs(t, err, ErrPasteUnsupported)
+
+	// 透過 SSH 連線時本機工具寫入的是遠端主機的剪貼簿，自動模式只使用 OSC 52。
+	t.Setenv("SSH_TTY", "/dev/pts/1")
+	require.NoError(t, Copy(ModeAuto, "遠端"))
+	assert.Equal(t, "第二段", clipped())
+}
+
+// TestCopyAndPaste_NoTool 測試沒有本機工具時自動模式仍可複製（只用 OSC 52），需要工具的操作返回 ErrNoTool。
+func TestCopyAndPaste_NoTool(t *testing.T) {
+	fakeEnv(t, false)
+
+	require.NoError(t, Copy(ModeAuto, "內容"))
+	assert.ErrorIs(t, Copy(ModeLocal, "內容"), ErrNoTool)
+	_, err := Paste(ModeAuto)
+	assert.ErrorIs(t, err, ErrNoTool)
+}
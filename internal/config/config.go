@@ -0,0 +1,112 @@
+// Package config 負責讀取使用者的 TOML 配置檔案。
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+)
+
+// fileName 是配置檔案在配置目錄中的名稱。
+const fileName = "config.toml"
+
+// Config 結構體代表使用者可調整的應用程式設定。
+type Config struct {
+	Editor    string              `toml:"editor"`    // 開啟筆記時使用的編輯器指令，可包含參數。
+	Locale    string              `toml:"locale"`    // 介面語系："zh-TW" 或 "en"；未設定時依 LC_ALL、LC_MESSAGES、LANG 判斷。
+	Keys      map[string][]string `toml:"keys"`      // TUI 快捷鍵覆蓋，鍵為動作名稱（例如 quit），值為按鍵列表。
+	Layout    string              `toml:"layout"`    // TUI 列表視圖的版面配置："split"（列表與預覽，預設）或 "single"。
+	Sort      string              `toml:"sort"`      // TUI 列表的初始排序，例如 "updated" 或 "title:asc"；預設依建立時間由舊到新。
+	Group     string              `toml:"group"`     // TUI 列表的初始分組："none"（預設）、"date"、"tag" 或 "folder"。
+	Theme     string              `toml:"theme"`     // TUI 主題：auto（預設）、dark、light、high-contrast 或 [themes] 中自訂的名稱。
+	Themes    map[string]Theme    `toml:"themes"`    // 使用者自訂的主題，鍵為主題名稱。
+	Mouse     *bool               `toml:"mouse"`     // TUI 是否接收滑鼠事件；未設定時啟用，設為 false 可保留終端原本的文字選取。
+	Clipboard string              `toml:"clipboard"` // TUI 複製與貼上剪貼簿的方式："auto"（預設）、"osc52" 或 "local"。
+	Vault     string              `toml:"vault"`     // 預設使用的筆記庫名稱，需定義於 [vaults]；未設定時使用預設的資料目錄。
+	Vaults    Vaults              `toml:"vaults"`    // 具名的筆記庫，鍵為名稱，值為存放筆記的目錄。
+}
+
+// Vaults 是具名的筆記庫，鍵為名稱，值為存放筆記的目錄，開頭的 ~ 代表家目錄。
+type Vaults map[string]string
+
+// Dir 返回名稱為 name 的筆記庫目錄；name 為空字串時返回空字串，代表預設的資料目錄。
+// 名稱未定義時 ok 為 false。
+func (v Vaults) Dir(name string) (dir string, ok bool) {
+	if name == "" {
+		return "", true
+	}
+	dir, ok = v[name]
+	if !ok {
+		return "", false
+	}
+	if home, err := os.UserHomeDir(); err == nil && (dir == "~" || strings.HasPrefix(dir, "~/")) {
+		dir = filepath.Join(home, dir[1:])
+	}
+	if abs, err := filepath.Abs(dir); err == nil {
+		dir = abs
+	}
+	return dir, true
+}
+
+// Names 返回依名稱排序的筆記庫名稱。
+func (v Vaults) Names() []string {
+	return slices.Sorted(maps.Keys(v))
+}
+
+// Theme 是使用者在配置檔案中自訂的主題，顏色可為 ANSI 色號（0-255）或 #RRGGBB。
+// 未設定的欄位沿用 Base 指定的內建主題。
+type Theme struct {
+	Base     string `toml:"base"`     // 作為基礎的內建主題，預設為 auto。
+	Accent   string `toml:"accent"`   // 標題列、使用者訊息與取得焦點的欄位。
+	Success  string `toml:"success"`  // 系統回應與一般狀態訊息。
+	Warning  string `toml:"warning"`  // 警告。
+	Error    string `toml:"error"`    // 錯誤與驗證訊息。
+	Match    string `toml:"match"`    // 篩選時符合的字元。
+	Muted    string `toml:"muted"`    // 框線、日期與標籤等次要文字。
+	Markdown string `toml:"markdown"` // glamour 的標準樣式名稱，例如 dark、light、dracula。
+}
+
+// Default 返回未提供配置檔案時的預設設定。
+func Default() Config {
+	return Config{}
+}
+
+// MouseEnabled 返回 TUI 是否啟用滑鼠，未設定時預設啟用。
+func (c Config) MouseEnabled() bool {
+	return c.Mouse == nil || *c.Mouse
+}
+
+// Path 返回配置檔案的完整路徑。
+func Path() (string, error) {
+	configDir, err := storage.GetConfigDir()
+	if err != nil {
+		return "", fmt.Errorf(i18n.T("config.dir_failed"), err)
+	}
+	return filepath.Join(configDir, fileName), nil
+}
+
+// Load 讀取配置目錄中的 config.toml。
+// 檔案不存在時返回預設設定而非錯誤。
+func Load() (Config, error) {
+	cfg := Default()
+	path, err := Path()
+	if err != nil {
+		return cfg, err
+	}
+	if _, err := toml.DecodeFile(path, &cfg); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return Default(), nil
+		}
+		return Default(), fmt.Errorf(i18n.T("config.parse_failed"), path, err)
+	}
+	return cfg, nil
+}
@@ -0,0 +1,130 @@
+// Package config 提供了配置檔案讀取的單元測試。
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+)
+
+// setupTestConfigDir 建立臨時的配置目錄並覆蓋測試配置路徑，返回實際的應用程式配置目錄。
+func setupTestConfigDir(t *testing.T) string {
+	tempDir := t.TempDir()
+	old := storage.GetTestConfigHome()
+	storage.SetTestConfigHome(tempDir)
+	t.Cleanup(func() { storage.SetTestConfigHome(old) })
+
+	dir, err := storage.GetConfigDir()
+	require.NoError(t, err)
+	return dir
+}
+
+// TestLoad_MissingFile 測試配置檔案不存在時返回預設設定。
+func TestLoad_MissingFile(t *testing.T) {
+	setupTestConfigDir(t)
+
+	cfg, err := Load()
+	assert.NoError(t, err)
+	assert.Equal(t, Default(), cfg)
+}
+
+// TestLoad_Editor 測試能從配置檔案讀取編輯器設定。
+func TestLoad_Editor(t *testing.T) {
+	dir := setupTestConfigDir(t)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.toml"), []byte("editor = \"code --wait\"\n"), 0644))
+
+	cfg, err := Load()
+	assert.NoError(t, err)
+	assert.Equal(t, "code --wait", cfg.Editor)
+}
+
+// TestLoad_Locale 測試能從配置檔案讀取介面語系。
+func TestLoad_Locale(t *testing.T) {
+	dir := setupTestConfigDir(t)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.toml"), []byte("locale = \"en\"\n"), 0644))
+
+	cfg, err := Load()
+	assert.NoError(t, err)
+	assert.Equal(t, "en", cfg.Locale)
+}
+
+// TestLoad_SortAndGroup 測試能從配置檔案讀取列表的排序與分組。
+func TestLoad_SortAndGroup(t *testing.T) {
+	dir := setupTestConfigDir(t)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.toml"), []byte("sort = \"updated:desc\"\ngroup = \"tag\"\n"), 0644))
+
+	cfg, err := Load()
+	assert.NoError(t, err)
+	assert.Equal(t, "updated:desc", cfg.Sort)
+	assert.Equal(t, "tag", cfg.Group)
+}
+
+// TestLoad_Mouse 測試滑鼠設定未設定時預設啟用，設為 false 時停用。
+func TestLoad_Mouse(t *testing.T) {
+	dir := setupTestConfigDir(t)
+	assert.True(t, Default().MouseEnabled())
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.toml"), []byte("mouse = false\n"), 0644))
+
+	cfg, err := Load()
+	assert.NoError(t, err)
+	assert.False(t, cfg.MouseEnabled())
+}
+
+// TestLoad_InvalidFile 測試配置檔案格式錯誤時返回錯誤。
+func TestLoad_InvalidFile(t *testing.T) {
+	dir := setupTestConfigDir(t)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.toml"), []byte("editor = \n"), 0644))
+
+	_, err := Load()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "解析配置檔案")
+}
+
+// TestLoad_Keys 測試能從配置檔案讀取 TUI 快捷鍵覆蓋。
+func TestLoad_Keys(t *testing.T) {
+	dir := setupTestConfigDir(t)
+	content := "[keys]\nquit = [\"ctrl+q\"]\nnew = [\"n\", \"a\"]\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.toml"), []byte(content), 0644))
+
+	cfg, err := Load()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]string{"quit": {"ctrl+q"}, "new": {"n", "a"}}, cfg.Keys)
+}
+
+// TestLoad_Themes 測試能從配置檔案讀取主題名稱與自訂主題。
+func TestLoad_Themes(t *testing.T) {
+	dir := setupTestConfigDir(t)
+	content := "theme = \"solar\"\n\n[themes.solar]\nbase = \"light\"\naccent = \"#268bd2\"\nmarkdown = \"light\"\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.toml"), []byte(content), 0644))
+
+	cfg, err := Load()
+	assert.NoError(t, err)
+	assert.Equal(t, "solar", cfg.Theme)
+	assert.Equal(t, map[string]Theme{"solar": {Base: "light", Accent: "#268bd2", Markdown: "light"}}, cfg.Themes)
+}
+
+// TestLoad_Vaults 測試能從配置檔案讀取筆記庫，並展開目錄開頭的 ~。
+func TestLoad_Vaults(t *testing.T) {
+	dir := setupTestConfigDir(t)
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	content := "vault = \"work\"\n\n[vaults]\nwork = \"~/notes/work\"\nhome = \"/srv/notes/home\"\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.toml"), []byte(content), 0644))
+
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, "work", cfg.Vault)
+	assert.Equal(t, []string{"home", "work"}, cfg.Vaults.Names())
+	got, ok := cfg.Vaults.Dir("work")
+	assert.True(t, ok)
+	assert.Equal(t, filepath.Join(home, "notes", "work"), got)
+	got, ok = cfg.Vaults.Dir("")
+	assert.True(t, ok)
+	assert.Empty(t, got)
+	_, ok = cfg.Vaults.Dir("play")
+	assert.False(t, ok)
+}
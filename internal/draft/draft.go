@@ -0,0 +1,136 @@
+// Package draft 負責保存 TUI 建立視圖中尚未送出的草稿，讓終端意外關閉後仍能還原。
+package draft
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+)
+
+// fileExt 是草稿檔案的副檔名。
+const fileExt = ".md"
+
+// 草稿檔案的格式：前兩行為標題與標籤欄位，分隔線之後為內容。
+const (
+	titlePrefix = "title: "
+	tagsPrefix  = "tags: "
+	separator   = "---"
+)
+
+// Draft 是一份尚未送出的草稿，保存建立視圖表單中各欄位的內容。
+type Draft struct {
+	ID        string    // 草稿識別碼，也是檔名（不含副檔名）。
+	Title     string    // 標題欄位。
+	Tags      string    // 標籤欄位的原始輸入，還原時保留使用者的寫法。
+	Body      string    // 內容欄位。
+	UpdatedAt time.Time // 最後一次自動儲存的時間。
+}
+
+// NewID 依建立時間產生草稿識別碼，精確到毫秒以避免同一秒內的多份草稿互相覆蓋。
+func NewID(t time.Time) string {
+	return t.Format("20060102-150405.000")
+}
+
+// Dir 返回草稿目錄（資料目錄下的 drafts）。
+func Dir() (string, error) {
+	dir, err := storage.GetAppDataSubDir("drafts")
+	if err != nil {
+		return "", fmt.Errorf(i18n.T("draft.dir_failed"), err)
+	}
+	return dir, nil
+}
+
+// Encode 返回草稿寫入檔案的內容。
+func (d Draft) Encode() string {
+	return titlePrefix + d.Title + "\n" + tagsPrefix + d.Tags + "\n" + separator + "\n" + d.Body
+}
+
+// decode 解析草稿檔案的內容。
+// AI 心智註解: 舊版草稿只保存輸入區的文字，沒有欄位標頭時沿用舊的規則：第一行為標題，其餘為內容。
+func decode(id, text string) Draft {
+	lines := strings.SplitN(text, "\n", 4)
+	if len(lines) >= 3 && strings.HasPrefix(lines[0], titlePrefix) && strings.HasPrefix(lines[1], tagsPrefix) && lines[2] == separator {
+		d := Draft{ID: id, Title: strings.TrimPrefix(lines[0], titlePrefix), Tags: strings.TrimPrefix(lines[1], tagsPrefix)}
+		if len(lines) == 4 {
+			d.Body = lines[3]
+		}
+		return d
+	}
+	title, body, _ := strings.Cut(text, "\n")
+	return Draft{ID: id, Title: strings.TrimSpace(title), Body: body}
+}
+
+// Blank 判斷草稿的所有欄位是否都是空白。
+func (d Draft) Blank() bool {
+	return strings.TrimSpace(d.Title) == "" && strings.TrimSpace(d.Tags) == "" && strings.TrimSpace(d.Body) == ""
+}
+
+// Save 將草稿寫入檔案，先寫入暫存檔再改名以避免寫到一半的檔案。
+func Save(d Draft) error {
+	dir, err := Dir()
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(dir, d.ID+fileExt)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(d.Encode()), 0600); err != nil {
+		return fmt.Errorf(i18n.T("draft.write_failed"), d.ID, err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf(i18n.T("draft.write_failed"), d.ID, err)
+	}
+	return nil
+}
+
+// List 返回所有草稿，最近更新的在前。
+func List() ([]Draft, error) {
+	dir, err := Dir()
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("draft.read_dir_failed"), err)
+	}
+	var drafts []Draft
+	for _, e := range entries {
+		// AI 心智註解: 略過寫到一半留下的 .tmp 檔，只列出完整寫入的草稿。
+		if e.IsDir() || !strings.HasSuffix(e.Name(), fileExt) {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("draft.read_failed"), e.Name(), err)
+		}
+		info, err := e.Info()
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("draft.read_failed"), e.Name(), err)
+		}
+		d := decode(strings.TrimSuffix(e.Name(), fileExt), string(data))
+		d.UpdatedAt = info.ModTime()
+		drafts = append(drafts, d)
+	}
+	sort.SliceStable(drafts, func(i, j int) bool { return drafts[i].UpdatedAt.After(drafts[j].UpdatedAt) })
+	return drafts, nil
+}
+
+// Delete 刪除指定的草稿，草稿不存在時不視為錯誤。
+func Delete(id string) error {
+	dir, err := Dir()
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(filepath.Join(dir, id+fileExt)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf(i18n.T("draft.delete_failed"), id, err)
+	}
+	return nil
+}
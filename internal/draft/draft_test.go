@@ -0,0 +1,67 @@
+// Package draft 提供了草稿保存的單元測試。
+package draft
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+)
+
+// setupTestDataHome 將資料目錄指向臨時目錄，並在測試結束後還原。
+func setupTestDataHome(t *testing.T) {
+	old := storage.GetTestDataHome()
+	storage.SetTestDataHome(t.TempDir())
+	t.Cleanup(func() { storage.SetTestDataHome(old) })
+}
+
+// TestSaveListDelete 測試草稿的寫入、覆寫、列出（最近更新在前）與刪除。
+func TestSaveListDelete(t *testing.T) {
+	setupTestDataHome(t)
+
+	require.NoError(t, Save(Draft{ID: "a", Title: "第一份"}))
+	require.NoError(t, Save(Draft{ID: "b", Title: "第二份", Tags: "work, idea", Body: "內容\n第二行"}))
+	require.NoError(t, Save(Draft{ID: "a", Title: "第一份（更新）"}))
+
+	dir, err := Dir()
+	require.NoError(t, err)
+	old := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(dir, "b.md"), old, old))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "c.md.tmp"), []byte("寫到一半"), 0600))
+
+	drafts, err := List()
+	require.NoError(t, err)
+	require.Len(t, drafts, 2)
+	assert.Equal(t, "a", drafts[0].ID)
+	assert.Equal(t, "第一份（更新）", drafts[0].Title)
+	drafts[1].UpdatedAt = time.Time{}
+	assert.Equal(t, Draft{ID: "b", Title: "第二份", Tags: "work, idea", Body: "內容\n第二行"}, drafts[1])
+
+	require.NoError(t, Delete("a"))
+	require.NoError(t, Delete("a"), "刪除不存在的草稿不應報錯")
+	drafts, err = List()
+	require.NoError(t, err)
+	assert.Len(t, drafts, 1)
+}
+
+// TestDecodeLegacy 測試沒有欄位標頭的舊版草稿以第一行為標題、其餘為內容。
+func TestDecodeLegacy(t *testing.T) {
+	d := decode("old", "  舊標題 \n第一行\n第二行")
+	assert.Equal(t, Draft{ID: "old", Title: "舊標題", Body: "第一行\n第二行"}, d)
+	assert.Equal(t, Draft{ID: "x", Title: "標題"}, decode("x", "title: 標題\ntags: \n---"))
+}
+
+// TestBlank 測試所有欄位都是空白時視為空白草稿。
+func TestBlank(t *testing.T) {
+	assert.True(t, Draft{ID: "a", Title: " ", Body: "\n"}.Blank())
+	assert.False(t, Draft{ID: "a", Tags: "work"}.Blank())
+}
+
+func TestNewID(t *testing.T) {
+	at := time.Date(2026, 10, 19, 14, 3, 5, 120_000_000, time.Local)
+	assert.Equal(t, "20261019-140305.120", NewID(at))
+}
@@ -0,0 +1,53 @@
+// Package editor 負責解析並建立開啟外部編輯器與瀏覽器的指令。
+package editor
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+)
+
+// fallbackEditor 是在未設定任何編輯器時使用的預設編輯器。
+const fallbackEditor = "vi"
+
+// Resolve 決定要使用的編輯器指令字串。
+// 優先順序為：配置檔案中的 editor、$VISUAL、$EDITOR，最後退回 vi。
+func Resolve(configured string) string {
+	candidates := []string{configured, os.Getenv("VISUAL"), os.Getenv("EDITOR")}
+	for _, c := range candidates {
+		if strings.TrimSpace(c) != "" {
+			return strings.TrimSpace(c)
+		}
+	}
+	return fallbackEditor
+}
+
+// Command 建立以編輯器開啟指定檔案的指令。
+// 編輯器設定可包含參數，例如 "code --wait"。
+func Command(configured, path string) (*exec.Cmd, error) {
+	fields := strings.Fields(Resolve(configured))
+	if len(fields) == 0 {
+		return nil, errors.New(i18n.T("editor.not_set"))
+	}
+	args := append(fields[1:], path)
+	return exec.Command(fields[0], args...), nil
+}
+
+// Browser 建立以瀏覽器開啟指定網址的指令。
+// 優先使用 $BROWSER（可包含參數），否則依作業系統使用 open、xdg-open 或 Windows 的預設處理程式。
+func Browser(url string) *exec.Cmd {
+	if fields := strings.Fields(os.Getenv("BROWSER")); len(fields) > 0 {
+		return exec.Command(fields[0], append(fields[1:], url)...)
+	}
+	switch runtime.GOOS {
+	case "darwin":
+		return exec.Command("open", url)
+	case "windows":
+		return exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	}
+	return exec.Command("xdg-open", url)
+}
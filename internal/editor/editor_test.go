@@ -0,0 +1,50 @@
+// Package editor 提供了編輯器指令解析的單元測試。
+package editor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestResolve 測試編輯器設定的優先順序。
+func TestResolve(t *testing.T) {
+	testCases := []struct {
+		name       string
+		configured string
+		visual     string
+		editor     string
+		expected   string
+	}{
+		{name: "配置檔案優先", configured: "nano", visual: "vim", editor: "emacs", expected: "nano"},
+		{name: "其次使用 VISUAL", visual: "vim", editor: "emacs", expected: "vim"},
+		{name: "再來使用 EDITOR", editor: "emacs", expected: "emacs"},
+		{name: "全部未設定時退回 vi", expected: "vi"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("VISUAL", tc.visual)
+			t.Setenv("EDITOR", tc.editor)
+			assert.Equal(t, tc.expected, Resolve(tc.configured))
+		})
+	}
+}
+
+// TestCommand 測試帶參數的編輯器設定會被正確拆分。
+func TestCommand(t *testing.T) {
+	cmd, err := Command("code --wait", "/tmp/note.md")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"code", "--wait", "/tmp/note.md"}, cmd.Args)
+}
+
+// TestBrowser 測試 $BROWSER 優先於作業系統預設的開啟方式，且可包含參數。
+func TestBrowser(t *testing.T) {
+	t.Setenv("BROWSER", "firefox --new-tab")
+	assert.Equal(t, []string{"firefox", "--new-tab", "https://example.com"}, Browser("https://example.com").Args)
+
+	t.Setenv("BROWSER", "")
+	args := Browser("https://example.com").Args
+	assert.Equal(t, "https://example.com", args[len(args)-1])
+}
@@ -0,0 +1,123 @@
+// Package export 將筆記匯出為 JSON、JSONL、靜態 HTML 網站或單一 Markdown 文件。
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+)
+
+// Format 代表匯出格式。
+type Format string
+
+const (
+	FormatJSON     Format = "json"      // 完整筆記記錄的 JSON 陣列。
+	FormatJSONL    Format = "jsonl"     // 每行一筆 JSON 記錄。
+	FormatHTML     Format = "html"      // 可瀏覽的靜態 HTML 網站。
+	FormatMDBundle Format = "md-bundle" // 串接成單一 Markdown 文件，適合貼進 AI 提示。
+)
+
+// Formats 列出所有支援的匯出格式。
+var Formats = []Format{FormatJSON, FormatJSONL, FormatHTML, FormatMDBundle}
+
+// Filter 描述要匯出哪些筆記，零值欄位表示不篩選。
+type Filter struct {
+	Tags   []string  // 筆記必須包含所有指定標籤。
+	After  time.Time // 建立時間不早於此時間。
+	Before time.Time // 建立時間早於此時間。
+	Folder string    // 筆記位於此資料夾或其子資料夾。
+}
+
+// Match 判斷筆記是否符合篩選條件。
+func (f Filter) Match(n *note.Note) bool {
+	for _, tag := range f.Tags {
+		if !slices.Contains(n.Tags, tag) {
+			return false
+		}
+	}
+	if !f.After.IsZero() && n.CreatedAt.Before(f.After) {
+		return false
+	}
+	if !f.Before.IsZero() && !n.CreatedAt.Before(f.Before) {
+		return false
+	}
+	if f.Folder != "" {
+		folder := strings.Trim(f.Folder, "/")
+		if n.Folder != folder && !strings.HasPrefix(n.Folder, folder+"/") {
+			return false
+		}
+	}
+	return true
+}
+
+// Apply 返回符合篩選條件的筆記，依建立時間由舊到新排序。
+func (f Filter) Apply(notes []*note.Note) []*note.Note {
+	var result []*note.Note
+	for _, n := range notes {
+		if f.Match(n) {
+			result = append(result, n)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].CreatedAt.Before(result[j].CreatedAt) })
+	return result
+}
+
+// WriteJSON 將筆記以縮排的 JSON 陣列寫出。
+func WriteJSON(w io.Writer, notes []*note.Note) error {
+	if notes == nil {
+		notes = []*note.Note{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(notes); err != nil {
+		return fmt.Errorf(i18n.T("export.json_failed"), err)
+	}
+	return nil
+}
+
+// WriteJSONL 將每篇筆記寫為一行 JSON。
+func WriteJSONL(w io.Writer, notes []*note.Note) error {
+	enc := json.NewEncoder(w)
+	for _, n := range notes {
+		if err := enc.Encode(n); err != nil {
+			return fmt.Errorf(i18n.T("export.jsonl_failed"), err)
+		}
+	}
+	return nil
+}
+
+// WriteBundle 將筆記串接為單一 Markdown 文件，每篇筆記附上標題與元資料，標題與欄位名稱使用目前語系。
+func WriteBundle(w io.Writer, notes []*note.Note) error {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("# %s\n\n", i18n.T("export.bundle_title")))
+	b.WriteString(i18n.T("export.bundle_count", len(notes)) + "\n")
+	for _, n := range notes {
+		b.WriteString("\n---\n\n")
+		b.WriteString(fmt.Sprintf("## %s\n\n", n.Title))
+		b.WriteString(fmt.Sprintf("- ID: %s\n", n.ID()))
+		b.WriteString(fmt.Sprintf("- %s: %s\n", i18n.T("export.created"), n.CreatedAt.Format(time.RFC3339)))
+		if !n.UpdatedAt.IsZero() {
+			b.WriteString(fmt.Sprintf("- %s: %s\n", i18n.T("export.updated"), n.UpdatedAt.Format(time.RFC3339)))
+		}
+		if n.Folder != "" {
+			b.WriteString(fmt.Sprintf("- %s: %s\n", i18n.T("export.folder"), n.Folder))
+		}
+		if len(n.Tags) > 0 {
+			b.WriteString(fmt.Sprintf("- %s: %s\n", i18n.T("export.tags"), strings.Join(n.Tags, ", ")))
+		}
+		b.WriteString("\n")
+		b.WriteString(strings.TrimRight(n.Content, "\n"))
+		b.WriteString("\n")
+	}
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf(i18n.T("export.markdown_failed"), err)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+// Package export 提供了筆記匯出的單元測試。
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+)
+
+// useLocale 在測試期間切換語系，結束時還原。
+func useLocale(t *testing.T, l i18n.Locale) {
+	old := i18n.Current()
+	i18n.Set(l)
+	t.Cleanup(func() { i18n.Set(old) })
+}
+
+// sampleNotes 返回匯出測試用的筆記。
+func sampleNotes() []*note.Note {
+	return []*note.Note{
+		{
+			Title:     "Go 筆記",
+			Content:   "# 標題\n\n參考 [[會議]] 與 [[不存在]]",
+			Tags:      []string{"go", "work"},
+			CreatedAt: time.Date(2026, 9, 10, 8, 0, 0, 0, time.UTC),
+			Folder:    "dev/lang",
+			Path:      "/tmp/should-not-export.md",
+		},
+		{
+			Title:     "會議",
+			Content:   "- [ ] 準備簡報",
+			Tags:      []string{"work"},
+			CreatedAt: time.Date(2026, 8, 1, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			Title:     "日記",
+			Content:   "今天天氣很好",
+			CreatedAt: time.Date(2026, 10, 1, 21, 0, 0, 0, time.UTC),
+			Folder:    "devops",
+		},
+	}
+}
+
+// TestFilterApply 測試標籤、日期與資料夾篩選，以及依建立時間排序。
+func TestFilterApply(t *testing.T) {
+	notes := sampleNotes()
+
+	all := Filter{}.Apply(notes)
+	require.Len(t, all, 3)
+	assert.Equal(t, "會議", all[0].Title)
+
+	assert.Len(t, Filter{Tags: []string{"work"}}.Apply(notes), 2)
+	assert.Len(t, Filter{Tags: []string{"work", "go"}}.Apply(notes), 1)
+
+	after := Filter{After: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)}.Apply(notes)
+	assert.Len(t, after, 2)
+	before := Filter{Before: time.Date(2026, 9, 10, 8, 0, 0, 0, time.UTC)}.Apply(notes)
+	require.Len(t, before, 1)
+	assert.Equal(t, "會議", before[0].Title)
+
+	// 資料夾篩選包含子資料夾，但不應匹配名稱相似的資料夾。
+	dev := Filter{Folder: "dev"}.Apply(notes)
+	require.Len(t, dev, 1)
+	assert.Equal(t, "Go 筆記", dev[0].Title)
+}
+
+// TestWriteJSON 測試 JSON 匯出包含完整記錄且不含檔案路徑。
+func TestWriteJSON(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteJSON(&buf, sampleNotes()[:1]))
+
+	var decoded []map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	require.Len(t, decoded, 1)
+	assert.Equal(t, "Go 筆記", decoded[0]["title"])
+	assert.Equal(t, "dev/lang", decoded[0]["folder"])
+	assert.NotContains(t, buf.String(), "should-not-export")
+	assert.NotContains(t, buf.String(), "updated_at")
+
+	buf.Reset()
+	require.NoError(t, WriteJSON(&buf, nil))
+	assert.Equal(t, "[]\n", buf.String())
+}
+
+// TestWriteJSONL 測試每篇筆記各佔一行。
+func TestWriteJSONL(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteJSONL(&buf, sampleNotes()))
+
+	scanner := bufio.NewScanner(&buf)
+	lines := 0
+	for scanner.Scan() {
+		var n note.Note
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &n))
+		lines++
+	}
+	assert.Equal(t, 3, lines)
+}
+
+// TestWriteBundle 測試 Markdown 合併文件的格式。
+func TestWriteBundle(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteBundle(&buf, sampleNotes()[:2]))
+	out := buf.String()
+
+	assert.True(t, strings.HasPrefix(out, "# Ora 筆記匯出\n\n共 2 篇筆記。\n"))
+	assert.Contains(t, out, "## Go 筆記\n\n- ID: 20260910080000\n")
+	assert.Contains(t, out, "- 資料夾: dev/lang\n- 標籤: go, work\n\n# 標題")
+	assert.Contains(t, out, "## 會議")
+}
+
+// TestWriteBundle_En 測試英文語系的合併文件使用英文的標題與欄位名稱。
+func TestWriteBundle_En(t *testing.T) {
+	useLocale(t, i18n.En)
+	var buf bytes.Buffer
+	require.NoError(t, WriteBundle(&buf, sampleNotes()[:1]))
+	out := buf.String()
+
+	assert.True(t, strings.HasPrefix(out, "# Ora note export\n\nNotes: 1\n"))
+	assert.Contains(t, out, "- Folder: dev/lang\n- Tags: go, work\n")
+}
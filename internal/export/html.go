@@ -0,0 +1,185 @@
+// Package export 將筆記匯出為 JSON、JSONL、靜態 HTML 網站或單一 Markdown 文件。
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+)
+
+// markdown 是轉換筆記內容的 Markdown 渲染器，啟用 GFM 表格與待辦清單。
+var markdown = goldmark.New(goldmark.WithExtensions(extension.GFM))
+
+// pageTemplate 是所有頁面共用的 HTML 版型。
+var pageTemplate = template.Must(template.New("page").Parse(`<!DOCTYPE html>
+<html lang="{{.Lang}}">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { max-width: 48rem; margin: 2rem auto; padding: 0 1rem; font-family: sans-serif; line-height: 1.6; }
+nav a { margin-right: 1rem; }
+.meta { color: #666; font-size: 0.9rem; }
+.tag { display: inline-block; margin-right: 0.5rem; }
+.broken-link { color: #b00; }
+</style>
+</head>
+<body>
+<nav><a href="index.html">{{.Index}}</a><a href="tags.html">{{.Tags}}</a></nav>
+<h1>{{.Title}}</h1>
+{{.Body}}
+</body>
+</html>
+`))
+
+// page 是 pageTemplate 的資料，Lang、Index 與 Tags 是目前語系的語言標籤與導覽連結文字。
+type page struct {
+	Lang  string
+	Index string
+	Tags  string
+	Title string
+	Body  template.HTML
+}
+
+// WriteHTML 在 dir 建立靜態網站：index.html 列出所有筆記、tags.html 為標籤索引，
+// 每篇筆記一個 <ID>.html（同一秒建立的筆記依序加上 -2、-3 等後綴），[[標題]] 連結會解析為對應的頁面。
+func WriteHTML(dir string, notes []*note.Note) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf(i18n.T("export.mkdir_failed"), dir, err)
+	}
+
+	byTitle := map[string]*note.Note{}
+	for _, n := range notes {
+		if _, ok := byTitle[n.Title]; !ok {
+			byTitle[n.Title] = n
+		}
+	}
+
+	names := pageNames(notes)
+	for _, n := range notes {
+		body, err := renderNote(n, byTitle, names)
+		if err != nil {
+			return err
+		}
+		if err := writePage(filepath.Join(dir, names[n]), n.Title, body); err != nil {
+			return err
+		}
+	}
+	if err := writePage(filepath.Join(dir, "index.html"), i18n.T("export.all_notes"), renderIndex(notes, names)); err != nil {
+		return err
+	}
+	return writePage(filepath.Join(dir, "tags.html"), i18n.T("export.tag_index"), renderTags(notes, names))
+}
+
+// pageNames 返回每篇筆記頁面的檔名。
+// AI 心智註解: 筆記 ID 只精確到秒，同一秒建立的筆記（例如批次匯入）會撞名，
+// 依筆記順序加上後綴，避免頁面互相覆寫、索引指向錯誤的筆記。
+func pageNames(notes []*note.Note) map[*note.Note]string {
+	names := make(map[*note.Note]string, len(notes))
+	used := map[string]bool{"index.html": true, "tags.html": true}
+	for _, n := range notes {
+		name := n.ID() + ".html"
+		for i := 2; used[name]; i++ {
+			name = fmt.Sprintf("%s-%d.html", n.ID(), i)
+		}
+		used[name] = true
+		names[n] = name
+	}
+	return names
+}
+
+// writePage 以共用版型寫出單一頁面。
+func writePage(path, title string, body template.HTML) error {
+	var buf bytes.Buffer
+	if err := pageTemplate.Execute(&buf, page{
+		Lang:  i18n.T("export.html_lang"),
+		Index: i18n.T("export.all_notes"),
+		Tags:  i18n.T("export.tag_index"),
+		Title: title,
+		Body:  body,
+	}); err != nil {
+		return fmt.Errorf(i18n.T("export.render_page_failed"), filepath.Base(path), err)
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf(i18n.T("export.write_page_failed"), path, err)
+	}
+	return nil
+}
+
+// renderNote 將筆記的元資料與內容渲染為 HTML。
+func renderNote(n *note.Note, byTitle map[string]*note.Note, names map[*note.Note]string) (template.HTML, error) {
+	// AI 心智註解: 先把 [[標題]] 換成標準 Markdown 連結，再交給 goldmark 渲染；
+	// 找不到目標的連結改為純文字並標示，避免產生失效的超連結。
+	content := note.ReplaceLinks(n.Content, func(title string) string {
+		if target, ok := byTitle[title]; ok {
+			return fmt.Sprintf("[%s](%s)", escapeLinkText(title), names[target])
+		}
+		return fmt.Sprintf("*%s*", escapeLinkText(title))
+	})
+
+	var buf bytes.Buffer
+	buf.WriteString(`<p class="meta">`)
+	buf.WriteString(template.HTMLEscapeString(n.CreatedAt.Format("2006-01-02 15:04")))
+	for _, tag := range n.Tags {
+		buf.WriteString(fmt.Sprintf(` <a class="tag" href="tags.html#%s">#%s</a>`, template.URLQueryEscaper(tag), template.HTMLEscapeString(tag)))
+	}
+	buf.WriteString("</p>\n")
+	if err := markdown.Convert([]byte(content), &buf); err != nil {
+		return "", fmt.Errorf(i18n.T("export.render_note_failed"), n.Title, err)
+	}
+	return template.HTML(buf.String()), nil
+}
+
+// renderIndex 渲染依建立時間排列的筆記列表。
+func renderIndex(notes []*note.Note, names map[*note.Note]string) template.HTML {
+	var buf bytes.Buffer
+	buf.WriteString("<ul>\n")
+	for _, n := range notes {
+		buf.WriteString(fmt.Sprintf(`<li><a href="%s">%s</a> <span class="meta">%s</span></li>`+"\n",
+			names[n], template.HTMLEscapeString(n.Title), n.CreatedAt.Format("2006-01-02")))
+	}
+	buf.WriteString("</ul>\n")
+	return template.HTML(buf.String())
+}
+
+// renderTags 渲染依標籤分組的筆記索引。
+func renderTags(notes []*note.Note, names map[*note.Note]string) template.HTML {
+	byTag := map[string][]*note.Note{}
+	for _, n := range notes {
+		for _, tag := range n.Tags {
+			byTag[tag] = append(byTag[tag], n)
+		}
+	}
+	tags := make([]string, 0, len(byTag))
+	for tag := range byTag {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	var buf bytes.Buffer
+	if len(tags) == 0 {
+		buf.WriteString("<p>" + template.HTMLEscapeString(i18n.T("export.no_tags")) + "</p>\n")
+	}
+	for _, tag := range tags {
+		buf.WriteString(fmt.Sprintf(`<h2 id="%s">#%s</h2>`+"\n<ul>\n", template.URLQueryEscaper(tag), template.HTMLEscapeString(tag)))
+		for _, n := range byTag[tag] {
+			buf.WriteString(fmt.Sprintf(`<li><a href="%s">%s</a></li>`+"\n", names[n], template.HTMLEscapeString(n.Title)))
+		}
+		buf.WriteString("</ul>\n")
+	}
+	return template.HTML(buf.String())
+}
+
+// escapeLinkText 轉義 Markdown 連結文字中的方括號。
+func escapeLinkText(s string) string {
+	return strings.NewReplacer("[", `\[`, "]", `\]`, "*", `\*`).Replace(s)
+}
@@ -0,0 +1,80 @@
+// Package export 提供了 HTML 網站匯出的單元測試。
+package export
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+)
+
+// TestWriteHTML 測試靜態網站的頁面、標籤索引與內部連結解析。
+func TestWriteHTML(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "site")
+	require.NoError(t, WriteHTML(dir, sampleNotes()))
+
+	read := func(name string) string {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		require.NoError(t, err)
+		return string(data)
+	}
+
+	index := read("index.html")
+	assert.Contains(t, index, `<html lang="zh-Hant">`)
+	assert.Contains(t, index, `<a href="index.html">所有筆記</a>`)
+	assert.Contains(t, index, `<a href="20260910080000.html">Go 筆記</a>`)
+	assert.Contains(t, index, `<a href="20261001210000.html">日記</a>`)
+
+	page := read("20260910080000.html")
+	assert.Contains(t, page, "<h1>標題</h1>")
+	assert.Contains(t, page, `<a href="20260801090000.html">會議</a>`)
+	assert.Contains(t, page, "<em>不存在</em>")
+	assert.Contains(t, page, `href="tags.html#go"`)
+
+	tags := read("tags.html")
+	assert.Contains(t, tags, `<h2 id="work">#work</h2>`)
+	assert.Contains(t, read("20260801090000.html"), `<input disabled="" type="checkbox"`)
+}
+
+// TestWriteHTML_En 測試英文語系的頁面使用英文的語言標籤與導覽文字。
+func TestWriteHTML_En(t *testing.T) {
+	useLocale(t, i18n.En)
+	dir := filepath.Join(t.TempDir(), "site")
+	require.NoError(t, WriteHTML(dir, nil))
+
+	data, err := os.ReadFile(filepath.Join(dir, "tags.html"))
+	require.NoError(t, err)
+	page := string(data)
+	assert.Contains(t, page, `<html lang="en">`)
+	assert.Contains(t, page, `<a href="index.html">All notes</a><a href="tags.html">Tag index</a>`)
+	assert.Contains(t, page, "<h1>Tag index</h1>")
+	assert.Contains(t, page, "<p>No tags.</p>")
+}
+
+// TestWriteHTML_SameSecond 測試同一秒建立的筆記各自有頁面，索引與連結指向正確的筆記。
+func TestWriteHTML_SameSecond(t *testing.T) {
+	created := time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC)
+	notes := []*note.Note{
+		{Title: "甲", Content: "見 [[乙]]", CreatedAt: created},
+		{Title: "乙", Content: "乙的內容", CreatedAt: created},
+	}
+	dir := filepath.Join(t.TempDir(), "site")
+	require.NoError(t, WriteHTML(dir, notes))
+
+	index, err := os.ReadFile(filepath.Join(dir, "index.html"))
+	require.NoError(t, err)
+	assert.Contains(t, string(index), `<a href="20261001080000.html">甲</a>`)
+	assert.Contains(t, string(index), `<a href="20261001080000-2.html">乙</a>`)
+
+	first, err := os.ReadFile(filepath.Join(dir, "20261001080000.html"))
+	require.NoError(t, err)
+	assert.Contains(t, string(first), `<a href="20261001080000-2.html">乙</a>`)
+	second, err := os.ReadFile(filepath.Join(dir, "20261001080000-2.html"))
+	require.NoError(t, err)
+	assert.Contains(t, string(second), "乙的內容")
+}
@@ -0,0 +1,454 @@
+// Package i18n 提供了 CLI 與 TUI 顯示文字的訊息目錄與語系選擇。
+package i18n
+
+// en 是英文的訊息目錄。
+var en = map[string]string{
+	// 語系
+	"locale.unsupported": "unsupported locale %q (available: %s)",
+
+	// storage 的錯誤
+	"storage.data_dir":              "failed to get data directory: %[2]s",
+	"storage.read_dir":              "failed to read data directory %s: %s",
+	"storage.mkdir":                 "failed to create folder %s: %s",
+	"storage.read":                  "failed to read file %s: %s",
+	"storage.write":                 "failed to write note to %s: %s",
+	"storage.rename":                "failed to rename note file %s: %s",
+	"storage.parse":                 "failed to parse note %s: %s",
+	"storage.trash":                 "failed to move note file %s to trash: %s",
+	"storage.not_found":             "no note with ID or title %s",
+	"storage.invalid_title":         "title contains characters not allowed in file names: %s",
+	"storage.invalid_folder":        "invalid folder name: %s",
+	"storage.front_matter":          "invalid front matter: %v",
+	"storage.front_matter_field":    "invalid %s in front matter: %q",
+	"storage.empty_content":         "content cannot be empty",
+	"storage.no_path":               "note has no file path and cannot be updated",
+	"storage.missing_front_matter":  "invalid file format: missing front matter start marker",
+	"storage.unclosed_front_matter": "invalid file format: missing front matter end marker",
+	"storage.note_exists":           "a note with the same file name already exists",
+	"storage.skipped_notes":         "skipped %d unreadable notes: %s",
+
+	// 提醒
+	"remind.not_found":      "no reminder with ID %s; run ora remind list to see reminders",
+	"remind.invalid_snooze": "snooze duration must be positive",
+	"remind.state_dir":      "failed to get reminder state directory: %[2]s",
+	"remind.read_state":     "failed to read reminder state %s: %s",
+	"remind.parse_state":    "failed to parse reminder state %s: %s",
+	"remind.write_state":    "failed to write reminder state %s: %s",
+	"remind.open_log":       "failed to open reminder log %s: %s",
+	"remind.write_log":      "failed to write reminder log %s: %s",
+	"remind.hook_failed":    "reminder hook failed: %s: %s",
+	"remind.due_title":      "%s (due)",
+	"remind.task_title":     "%s (%s)",
+
+	// 待辦項目參照
+	"task.invalid_ref": "invalid task reference %q; use a reference printed by ora task list",
+	"task.no_line":     "line %d does not exist",
+	"task.not_task":    "line %d is not a task",
+	"task.changed":     "line %d changed after the tasks were listed; list them again",
+
+	// TUI 快捷鍵說明
+	"key.up":              "up",
+	"key.down":            "down",
+	"key.page_up":         "page up",
+	"key.page_down":       "page down",
+	"key.open":            "open",
+	"key.filter":          "filter",
+	"key.search":          "search",
+	"key.next_match":      "next",
+	"key.prev_match":      "previous",
+	"key.toggle_raw":      "raw",
+	"key.preview":         "preview",
+	"key.back":            "back",
+	"key.next_field":      "next field",
+	"key.prev_field":      "previous field",
+	"key.new":             "new note",
+	"key.edit":            "edit",
+	"key.tasks":           "tasks",
+	"key.toggle_task":     "toggle task",
+	"key.error_log":       "error log",
+	"key.drafts":          "drafts",
+	"key.discard":         "discard draft",
+	"key.help":            "more",
+	"key.quit":            "quit",
+	"key.unknown_action":  "unknown key action %q",
+	"key.submit":          "submit",
+	"key.newline":         "newline",
+	"key.tag_separator":   "separate tags",
+	"key.select_tag":      "select tag",
+	"key.accept_tag":      "complete",
+	"key.apply_filter":    "apply filter",
+	"key.sort":            "sort",
+	"key.reverse":         "reverse sort",
+	"key.group":           "group",
+	"key.pin":             "pin",
+	"key.jump":            "open pinned note",
+	"key.select_note":     "select note",
+	"key.select_range":    "select range",
+	"key.add_tags":        "add tags",
+	"key.remove_tags":     "remove tags",
+	"key.move":            "move",
+	"key.trash":           "trash",
+	"key.copy_selection":  "copy selection",
+	"key.yank":            "copy",
+	"key.paste_clipboard": "paste clipboard",
+	"key.apply":           "apply",
+	"key.confirm":         "confirm",
+	"key.cancel":          "cancel",
+	"key.palette":         "command palette",
+	"key.run":             "run",
+	"key.select":          "select",
+
+	// TUI 狀態列與錯誤紀錄
+	"status.warning":    "warning: ",
+	"status.error":      "error: ",
+	"status.log_header": "Error log:",
+	"status.log_empty":  "No errors logged.",
+
+	// 草稿
+	"draft.autosave_failed": "failed to autosave draft: %s",
+	"draft.load_failed":     "failed to load drafts: %s",
+	"draft.notice":          "%d unsent draft(s); press %s to review and restore.",
+	"draft.header":          "Unsent drafts:",
+	"draft.empty":           "No unsent drafts.",
+	"draft.untitled":        "(untitled)",
+	"draft.entry":           "%s %s  %s  (%d chars)",
+	"draft.dir_failed":      "failed to get draft directory: %w",
+	"draft.read_dir_failed": "failed to read draft directory: %w",
+	"draft.read_failed":     "failed to read draft %s: %w",
+	"draft.write_failed":    "failed to write draft %s: %w",
+	"draft.delete_failed":   "failed to delete draft %s: %w",
+
+	// TUI 背景讀取
+	"load.cancel_hint":  "%s (%s to cancel)",
+	"load.saving":       "Saving…",
+	"load.searching":    "Searching content…",
+	"load.notes":        "Loading notes…",
+	"load.note":         "Opening note…",
+	"load.tasks":        "Loading tasks…",
+	"load.canceled":     "Loading canceled",
+	"load.note_updated": "Updated note \"%s\"",
+	"load.task_done":    "Task completed",
+	"load.pinned":       "Pinned \"%s\"",
+	"load.unpinned":     "Unpinned \"%s\"",
+	"load.no_pinned":    "No pinned note for key %s",
+
+	// 讀取與儲存失敗
+	"error.find_note":           "failed to find note: %s",
+	"error.pin":                 "failed to change pin: %s",
+	"error.read_note":           "failed to read note: %s",
+	"error.toggle_task":         "failed to toggle task: %s",
+	"error.save_note":           "failed to save note: %s",
+	"error.load_notes":          "failed to load notes: %s",
+	"error.load_tasks":          "failed to load tasks: %s",
+	"error.edited_note_invalid": "edited note is invalid: %s",
+	"error.search_content":      "content search failed: %s",
+	"error.editor":              "editor failed: %v",
+	"error.load_views":          "failed to read view history: %v",
+	"error.record_view":         "failed to record view: %v",
+	"error.export":              "failed to export notes: %s",
+	"error.open_link":           "cannot open link %s: %v",
+	"error.copy":                "failed to copy to clipboard: %v",
+	"error.paste":               "failed to paste from clipboard: %v",
+
+	// 配置檔案
+	"config.load_failed":       "failed to load config, using defaults: %v",
+	"config.keys_invalid":      "ignored invalid key settings, other key settings still apply: %v",
+	"config.theme_invalid":     "invalid theme config, using the %s theme: %v",
+	"config.layout_invalid":    "unknown layout %q (available: %s or %s), using %s",
+	"config.clipboard_invalid": "unknown clipboard mode %q (available: auto, osc52 or local), using %s",
+	"config.dir_failed":        "failed to get config directory: %w",
+	"config.parse_failed":      "failed to parse config file %s: %w",
+	"config.sort_invalid":      "unknown sort %q, using %s",
+	"config.group_invalid":     "unknown group %q, using %s",
+
+	// TUI 建立表單
+	"form.title":            "Title: ",
+	"form.tags":             "Tags:  ",
+	"form.invalid_tag":      "tag contains illegal characters (%s): %s",
+	"form.title_required":   "note title cannot be empty",
+	"form.saved":            "Saved note \"%s\" (%s)",
+	"form.body_placeholder": "Content… Enter to submit / Ctrl+J for newline",
+	"form.header":           "New note:",
+
+	// TUI 列表與篩選
+	"list.invalid_date":       "%s: date must be YYYY-MM-DD",
+	"list.error_separator":    "; ",
+	"list.filter_placeholder": "title, #tag, after:2026-09-01",
+	"list.header":             "Your notes:",
+	"list.vault":              "vault %s",
+	"list.loading":            "Please wait…",
+	"list.canceled":           "Loading notes was canceled.",
+	"list.empty":              "No notes found. Press '%s' to create a note.",
+	"list.no_match":           "No notes match the filter.",
+	"list.separator":          ", ",
+
+	// TUI 詳細視圖
+	"detail.render_failed":      "failed to render Markdown: %v",
+	"detail.search_placeholder": "search this note",
+	"detail.no_match":           "\"%s\" not found",
+	"detail.match":              "\"%s\" %d/%d",
+	"detail.rendered":           "rendered",
+	"detail.raw":                "raw",
+	"detail.header":             "Note: %s",
+
+	// TUI 大量貼上
+	"paste.prompt": "Large paste (%d lines, %d chars): 's' saves it as a note, 'i' inserts it, 'esc' cancels.",
+	"paste.title":  "Pasted %s",
+	"paste.saved":  "Saved pasted content as note \"%s\" (%s)",
+
+	// TUI 待辦事項
+	"tasks.header": "Tasks:",
+	"tasks.empty":  "No open tasks.",
+
+	// TUI 主題
+	"theme.invalid_color":      "invalid %s colour %q: use a colour number from 0-255 or #RRGGBB",
+	"theme.color_out_of_range": "invalid %s colour %q: colour numbers must be between 0 and 255",
+	"theme.unknown":            "unknown theme %q (available: %s or a name defined under [themes])",
+	"theme.invalid_base":       "base %[2]q of theme %[1]q is not a built-in theme (available: %[3]s)",
+	"theme.error":              "theme %q: %w",
+	"theme.unknown_markdown":   "markdown style %[2]q of theme %[1]q does not exist",
+
+	// 命令與旗標說明
+	"cmd.ora.short":                       "Ora is a quick note-taking app for AI workflows",
+	"cmd.ora.long":                        "Ora is a command-line app for quickly creating and managing notes, designed to work alongside AI CLI agents.",
+	"cmd.ora.flag.vault":                  "name of the vault to use, defined under [vaults] in the config file; defaults to vault in the config file",
+	"cmd.ora.note.short":                  "Manage your notes",
+	"cmd.ora.note.long":                   "Commands for creating, viewing and managing notes.",
+	"cmd.ora.note.new.short":              "Create a new note",
+	"cmd.ora.note.new.long":               "Interactively create a new note by entering a title, content and optional tags.",
+	"cmd.ora.note.edit.short":             "Open a note in an editor",
+	"cmd.ora.note.edit.long":              "Open the note file with the editor from the config file, $VISUAL or $EDITOR; <id> can be a note ID or title.",
+	"cmd.ora.note.list.short":             "List notes",
+	"cmd.ora.note.list.long":              "List every note's date, ID, title and tags, sorted by created or updated time, title, size or last viewed time, and optionally grouped by date, tag or folder.",
+	"cmd.ora.note.list.flag.sort":         "sort order: created, updated, title, size or viewed, optionally followed by :asc or :desc",
+	"cmd.ora.note.list.flag.group":        "grouping: none, date, tag or folder",
+	"cmd.ora.note.pin.short":              "Pin a note",
+	"cmd.ora.note.pin.long":               "Pin a note so it always appears at the top of the TUI list and can be opened with 1–9 from the list; <id> is a note ID or title.",
+	"cmd.ora.note.unpin.short":            "Unpin a note",
+	"cmd.ora.note.unpin.long":             "Unpin a note; <id> is a note ID or title.",
+	"cmd.ora.task.short":                  "Manage tasks in notes",
+	"cmd.ora.task.long":                   "Collect Markdown tasks (- [ ]) from all notes, with support for due:YYYY-MM-DD, @person and !high/!medium/!low markers.",
+	"cmd.ora.task.list.short":             "List tasks",
+	"cmd.ora.task.list.long":              "List tasks from all notes, optionally filtered by completion and due date.",
+	"cmd.ora.task.done.short":             "Toggle a task's completion",
+	"cmd.ora.task.done.long":              "Toggle a task's checkbox and write it back to its note; <ref> has the form <note file>:<line>:<checksum>, as shown by 'ora task list'. The task is not toggled if the note changed after it was listed.",
+	"cmd.ora.remind.short":                "Manage reminders for notes and tasks",
+	"cmd.ora.remind.long":                 "Schedule reminders from the remind_at/due front matter fields of notes and the due: markers of tasks.",
+	"cmd.ora.remind.list.short":           "List all reminders",
+	"cmd.ora.remind.daemon.short":         "Start the reminder daemon",
+	"cmd.ora.remind.daemon.long":          "Periodically check for due reminders and send notifications. Writes to stdout by default; use --hook to run a command or --log to append to a log file. Delivery state is persisted, so reminders are not repeated after a restart.",
+	"cmd.ora.remind.snooze.short":         "Snooze a reminder",
+	"cmd.ora.remind.snooze.long":          "Postpone a reminder by the given duration, e.g. 'ora remind snooze 20261001090000 1h'. <id> is shown by 'ora remind list'.",
+	"cmd.ora.import.short":                "Import notes from Obsidian, Joplin or a Markdown folder",
+	"cmd.ora.import.long":                 "Convert an Obsidian vault, a Joplin RAW export or a plain Markdown folder into Ora notes, keeping creation times, tags, folders, attachments and internal links. Notes imported earlier are skipped.",
+	"cmd.ora.export.short":                "Export notes",
+	"cmd.ora.export.long":                 "Export notes as json, jsonl, html (static site) or md-bundle (a single Markdown document suited to AI prompts), filtered by tag, date and folder.",
+	"cmd.ora.tui.short":                   "Start the TUI",
+	"cmd.ora.tui.long":                    "Start the interactive terminal user interface for managing notes.",
+	"cmd.ora.task.list.flag.open":         "only list open tasks",
+	"cmd.ora.task.list.flag.due-before":   "only list tasks due before this date (YYYY-MM-DD)",
+	"cmd.ora.remind.daemon.flag.interval": "interval between reminder checks",
+	"cmd.ora.remind.daemon.flag.hook":     "shell command to run when a reminder is due ($ORA_REMINDER_ID, $ORA_REMINDER_TITLE and $ORA_REMINDER_AT are available)",
+	"cmd.ora.remind.daemon.flag.log":      "path of a log file to append reminders to",
+	"cmd.ora.remind.daemon.flag.quiet":    "do not print reminders to stdout",
+	"cmd.ora.export.flag.format":          "export format: json, jsonl, html or md-bundle",
+	"cmd.ora.export.flag.output":          "output file (output directory for html); defaults to stdout",
+	"cmd.ora.export.flag.tag":             "only export notes with these tags (repeatable)",
+	"cmd.ora.export.flag.after":           "only export notes created on or after this date (YYYY-MM-DD)",
+	"cmd.ora.export.flag.before":          "only export notes created before this date (YYYY-MM-DD)",
+	"cmd.ora.export.flag.folder":          "only export notes in this folder (including subfolders)",
+
+	// 命令列
+	"cli.welcome":               "Welcome to Ora! Run 'ora --help' for more information.",
+	"cli.warning":               "warning: %v",
+	"cli.error":                 "error: %v",
+	"cli.app_error":             "application error: %v",
+	"cli.config_dir_failed":     "failed to get config directory: %w",
+	"cli.data_dir_failed":       "failed to get data directory: %w",
+	"cli.config_dir":            "Config directory: %s",
+	"cli.data_dir":              "Data directory: %s",
+	"cli.prompt_title":          "Note title: ",
+	"cli.prompt_content":        "Note content (press Enter twice to finish):",
+	"cli.prompt_tags":           "Tags (comma-separated, optional): ",
+	"cli.note_created":          "New note created:",
+	"cli.note_title":            "Title: %s",
+	"cli.note_content":          "Content: %s",
+	"cli.note_tags":             "Tags: %v",
+	"cli.note_created_at":       "Created: %s",
+	"cli.note_saved":            "Note created and saved!",
+	"cli.read_config_failed":    "failed to read config: %v",
+	"cli.editor_command_failed": "failed to build editor command: %v",
+	"cli.edited_note_invalid":   "edited note is invalid, file was not modified: %s",
+	"cli.note_updated":          "Note updated: %s (%s)",
+	"cli.pinned":                "Note pinned: %s (%s)",
+	"cli.unpinned":              "Note unpinned: %s (%s)",
+	"cli.invalid_date":          "invalid date %s, expected YYYY-MM-DD: %v",
+	"cli.no_tasks":              "No matching tasks.",
+	"cli.task_done":             "Done: %s",
+	"cli.task_reopened":         "Reopened: %s",
+	"cli.no_reminders":          "No reminders scheduled.",
+	"cli.no_notifier":           "no notification method: remove --quiet or set --hook/--log",
+	"cli.remind_check_failed":   "reminder check failed: %s",
+	"cli.daemon_failed":         "reminder daemon error: %v",
+	"cli.invalid_duration":      "invalid snooze duration %s: %v",
+	"cli.snooze_failed":         "failed to snooze reminder: %v",
+	"cli.snoozed":               "Reminder %s snoozed until %s",
+	"cli.import_failed":         "import failed: %s",
+	"cli.imported":              "Imported %d note(s).",
+	"cli.import_skipped":        "Skipped %d previously imported note(s).",
+	"cli.import_problems":       "Items that could not be converted (%d):",
+	"cli.unsupported_format":    "unsupported export format: %s (available: %v)",
+	"cli.invalid_flag":          "invalid %s: %v",
+	"cli.html_output_required":  "html format requires an output directory via --output",
+	"cli.exported":              "Exported %d note(s) to %s",
+	"cli.create_output_failed":  "failed to create output file: %v",
+	"cli.export_failed":         "export failed: %s",
+	"cli.tui_error":             "TUI error: %v",
+	"cli.unknown_vault":         "no vault named %q under [vaults] in the config file",
+	"cli.invalid_sort":          "invalid sort %q (available: %s, optionally followed by :asc or :desc)",
+	"cli.invalid_group":         "invalid group %q (available: %s)",
+	"cli.no_notes":              "No notes yet.",
+
+	// 列表的排序與分組
+	"order.key.created":       "created",
+	"order.key.updated":       "updated",
+	"order.key.title":         "title",
+	"order.key.size":          "size",
+	"order.key.viewed":        "last viewed",
+	"order.asc":               "↑",
+	"order.desc":              "↓",
+	"order.group.date":        "date",
+	"order.group.tag":         "tag",
+	"order.group.folder":      "folder",
+	"order.sort_label":        "sort: %s %s",
+	"order.group_label":       "group: %s",
+	"order.bucket.today":      "Today",
+	"order.bucket.yesterday":  "Yesterday",
+	"order.bucket.this_week":  "This week",
+	"order.bucket.this_month": "This month",
+	"order.bucket.this_year":  "This year",
+	"order.bucket.older":      "Earlier",
+	"order.bucket.never":      "Never viewed",
+	"order.untagged":          "Untagged",
+	"order.root":              "(root)",
+	"order.pinned":            "Pinned",
+
+	// TUI 列表動作
+	"command.open":           "Open selected note",
+	"command.filter":         "Filter and search notes",
+	"command.new":            "New note",
+	"command.edit":           "Open note in editor",
+	"command.tasks":          "Open tasks",
+	"command.drafts":         "Open drafts",
+	"command.preview":        "Toggle preview pane",
+	"command.export":         "Export listed or selected notes",
+	"command.sort":           "Change sort order",
+	"command.reverse":        "Reverse sort direction",
+	"command.group":          "Change grouping",
+	"command.pin":            "Pin or unpin note",
+	"command.select":         "Select or deselect note",
+	"command.select_range":   "Start or end range selection",
+	"command.add_tags":       "Add tags to selected notes",
+	"command.remove_tags":    "Remove tags from selected notes",
+	"command.move":           "Move selected notes to a folder",
+	"command.trash":          "Move selected notes to trash",
+	"command.copy_selection": "Copy selected notes to clipboard",
+	"command.yank":           "Copy note body, title, path or link",
+	"command.copy_body":      "Copy note body",
+	"command.copy_title":     "Copy note title",
+	"command.copy_path":      "Copy note file path",
+	"command.copy_link":      "Copy note [[link]]",
+	"command.theme":          "Switch theme",
+	"command.error_log":      "Open error log",
+	"command.quit":           "Quit",
+	"command.export_empty":   "No listed notes to export",
+	"command.exported":       "Exported %d notes to %s",
+	"command.theme_switched": "Switched to the %s theme",
+	"command.theme_failed":   "cannot switch to the %s theme: %v",
+	"command.vault":          "Switch to vault %s",
+	"command.vault_default":  "default",
+	"command.vault_switched": "Switched to vault %s",
+	"command.vault_busy":     "Notes are being saved; switch vaults after saving finishes",
+	"command.mouse":          "Toggle mouse mode",
+
+	// TUI 命令面板
+	"palette.header":      "Command palette",
+	"palette.placeholder": "Search actions or note titles",
+	"palette.selected":    "Selected note: %s",
+	"palette.note":        "note",
+	"palette.no_match":    "No matching actions or notes",
+
+	// TUI 滑鼠
+	"mouse.enabled":        "Mouse enabled",
+	"mouse.disabled":       "Mouse disabled; you can select text in the terminal",
+	"mouse.link_not_found": "No note titled \"%s\"",
+	"mouse.link_opened":    "Opened %s in the browser",
+
+	// TUI 多選與批次動作
+	"bulk.selected":           "%d selected",
+	"bulk.visual":             "-- VISUAL %d --",
+	"bulk.add_tags_prompt":    "Add tags to %d notes (comma separated):",
+	"bulk.remove_tags_prompt": "Remove tags from %d notes (comma separated):",
+	"bulk.move_prompt":        "Move %d notes to folder (empty for top level):",
+	"bulk.trash_prompt":       "Move %d notes to trash? (y/N)",
+	"bulk.tagged":             "Added tags to %d notes",
+	"bulk.untagged":           "Removed tags from %d notes",
+	"bulk.moved":              "Moved %d notes",
+	"bulk.trashed":            "Moved %d notes to trash",
+	"bulk.failed":             "%d notes done, %d failed: %s",
+	"bulk.copied":             "Copied %d notes to the clipboard",
+
+	// TUI 剪貼簿
+	"yank.prompt":          "Copy: b body, t title, p file path, l [[link]]; any other key cancels.",
+	"yank.body":            "Copied the body of \"%[1]s\"",
+	"yank.title":           "Copied title \"%[1]s\"",
+	"yank.path":            "Copied file path %[2]s",
+	"yank.link":            "Copied link %[2]s",
+	"yank.clipboard_empty": "The clipboard is empty",
+
+	// 匯出
+	"export.json_failed":        "failed to write JSON: %w",
+	"export.jsonl_failed":       "failed to write JSONL: %w",
+	"export.markdown_failed":    "failed to write Markdown: %w",
+	"export.bundle_title":       "Ora note export",
+	"export.bundle_count":       "Notes: %d",
+	"export.created":            "Created",
+	"export.updated":            "Updated",
+	"export.folder":             "Folder",
+	"export.tags":               "Tags",
+	"export.html_lang":          "en",
+	"export.all_notes":          "All notes",
+	"export.tag_index":          "Tag index",
+	"export.no_tags":            "No tags.",
+	"export.mkdir_failed":       "failed to create output directory %s: %w",
+	"export.render_page_failed": "failed to render page %s: %w",
+	"export.write_page_failed":  "failed to write page %s: %w",
+	"export.render_note_failed": "failed to render note %s: %w",
+
+	// 匯入
+	"import.unsupported_format":     "unsupported import format: %s",
+	"import.stat_failed":            "failed to read import source %s: %w",
+	"import.not_dir":                "import source must be a folder: %s",
+	"import.read_source_failed":     "failed to read import source: %w",
+	"import.read_entry_failed":      "failed to read %s: %w",
+	"import.data_dir_failed":        "failed to get data directory: %w",
+	"import.attachment_dir_failed":  "failed to get attachment directory: %w",
+	"import.untitled":               "Untitled",
+	"import.existing_unreadable":    "cannot read existing note: %s",
+	"import.save_failed":            "%s: cannot save note: %s",
+	"import.title_renamed":          "%s: title contains illegal characters, renamed to %q",
+	"import.front_matter_kept":      "%s: front matter could not be parsed and was kept in the body: %v",
+	"import.created_invalid":        "%s: cannot parse creation time %v, using the file modification time",
+	"import.created_now":            "%s: cannot parse creation time, using the current time",
+	"import.field_dropped":          "%s: front matter field %q was not converted",
+	"import.link_unresolved":        "%s: cannot resolve internal link %s",
+	"import.link_target_missing":    "%s: link target :/%s not found",
+	"import.attachment_missing":     "%s: attachment %s not found",
+	"import.attachment_copy_failed": "%s: failed to copy attachment %s: %v",
+	"import.resource_copy_failed":   "%s: failed to copy resource %s: %v",
+	"import.not_joplin":             "joplin:%s: not a Joplin export item, skipped",
+	"import.unsupported_type":       "joplin:%s: unsupported item type %s, skipped",
+
+	// 外部編輯器
+	"editor.not_set": "no editor configured",
+}
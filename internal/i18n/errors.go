@@ -0,0 +1,108 @@
+// Package i18n 提供了 CLI 與 TUI 顯示文字的訊息目錄與語系選擇。
+package i18n
+
+import (
+	"strings"
+
+	"github.com/wtg42/ora-ora-ora/internal/remind"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+	"github.com/wtg42/ora-ora-ora/internal/task"
+)
+
+// opKeys 是 storage 各項操作失敗時的訊息鍵，訊息依序接受路徑與底層錯誤。
+var opKeys = map[storage.Op]string{
+	storage.OpDataDir: "storage.data_dir",
+	storage.OpReadDir: "storage.read_dir",
+	storage.OpMkdir:   "storage.mkdir",
+	storage.OpRead:    "storage.read",
+	storage.OpWrite:   "storage.write",
+	storage.OpRename:  "storage.rename",
+	storage.OpParse:   "storage.parse",
+	storage.OpTrash:   "storage.trash",
+}
+
+// sentinelKeys 是 storage 與 remind 固定錯誤的訊息鍵。
+var sentinelKeys = map[error]string{
+	storage.ErrEmptyContent:        "storage.empty_content",
+	storage.ErrNoPath:              "storage.no_path",
+	storage.ErrMissingFrontMatter:  "storage.missing_front_matter",
+	storage.ErrUnclosedFrontMatter: "storage.unclosed_front_matter",
+	storage.ErrNoteExists:          "storage.note_exists",
+	remind.ErrInvalidSnooze:        "remind.invalid_snooze",
+}
+
+// remindOpKeys 是提醒狀態檔與日誌檔各項操作失敗時的訊息鍵，訊息依序接受路徑與底層錯誤。
+var remindOpKeys = map[remind.Op]string{
+	remind.OpStateDir:   "remind.state_dir",
+	remind.OpReadState:  "remind.read_state",
+	remind.OpParseState: "remind.parse_state",
+	remind.OpWriteState: "remind.write_state",
+	remind.OpOpenLog:    "remind.open_log",
+	remind.OpWriteLog:   "remind.write_log",
+}
+
+// lineKeys 是切換待辦項目失敗的原因對應的訊息鍵，訊息接受行號。
+var lineKeys = map[error]string{
+	task.ErrNoLine:  "task.no_line",
+	task.ErrNotTask: "task.not_task",
+	task.ErrChanged: "task.changed",
+}
+
+// Error 以目前語系返回錯誤的說明文字。
+// storage、remind 與 task 的具型別錯誤會被翻譯，其他錯誤返回 err.Error()。
+// AI 心智註解: 只翻譯最外層的錯誤（PathError 與 errors.Join 合併的錯誤再遞迴翻譯其內層），
+// 其他套件以 fmt.Errorf 包裝的錯誤保留原本的文字與上下文，不以 errors.As 取出內層而丟失資訊。
+func Error(err error) string {
+	if err == nil {
+		return ""
+	}
+	switch e := err.(type) {
+	case *storage.PathError:
+		if key, ok := opKeys[e.Op]; ok {
+			return T(key, e.Path, Error(e.Err))
+		}
+	case *storage.NotFoundError:
+		return T("storage.not_found", e.Ref)
+	case *storage.InvalidNameError:
+		if e.Folder {
+			return T("storage.invalid_folder", e.Name)
+		}
+		return T("storage.invalid_title", e.Name)
+	case *storage.FrontMatterError:
+		if e.Field == "" {
+			return T("storage.front_matter", e.Err)
+		}
+		return T("storage.front_matter_field", e.Field, e.Value)
+	case *remind.NotFoundError:
+		return T("remind.not_found", e.ID)
+	case *remind.PathError:
+		if key, ok := remindOpKeys[e.Op]; ok {
+			return T(key, e.Path, Error(e.Err))
+		}
+	case *remind.HookError:
+		return T("remind.hook_failed", e.Err, strings.TrimSpace(e.Output))
+	case *task.RefError:
+		return T("task.invalid_ref", e.Ref)
+	case *task.LineError:
+		if key, ok := lineKeys[e.Err]; ok {
+			return T(key, e.Line)
+		}
+	case *storage.SkippedNotesError:
+		msgs := make([]string, len(e.Errs))
+		for i, err := range e.Errs {
+			msgs[i] = Error(err)
+		}
+		return T("storage.skipped_notes", len(e.Errs), strings.Join(msgs, "; "))
+	case interface{ Unwrap() []error }:
+		// errors.Join 合併的錯誤逐一翻譯，保留每行一個錯誤的格式。
+		var msgs []string
+		for _, err := range e.Unwrap() {
+			msgs = append(msgs, Error(err))
+		}
+		return strings.Join(msgs, "\n")
+	}
+	if key, ok := sentinelKeys[err]; ok {
+		return T(key)
+	}
+	return err.Error()
+}
@@ -0,0 +1,56 @@
+// Package i18n 提供了錯誤翻譯的單元測試。
+package i18n
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wtg42/ora-ora-ora/internal/remind"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+	"github.com/wtg42/ora-ora-ora/internal/task"
+)
+
+// TestError 測試 storage、remind 與 task 的具型別錯誤及 errors.Join 合併的錯誤依語系翻譯。
+func TestError(t *testing.T) {
+	parse := &storage.PathError{Op: storage.OpParse, Path: "a.md", Err: &storage.FrontMatterError{Field: "due", Value: "明天"}}
+	tests := []struct {
+		err  error
+		zhTW string
+		en   string
+	}{
+		{&storage.NotFoundError{Ref: "x"}, "找不到 ID 或標題為 x 的筆記", "no note with ID or title x"},
+		{&storage.InvalidNameError{Name: "a/b"}, "標題包含非法字元，無法作為檔案名稱: a/b", "title contains characters not allowed in file names: a/b"},
+		{&storage.InvalidNameError{Folder: true, Name: "../x"}, "資料夾名稱無效: ../x", "invalid folder name: ../x"},
+		{storage.ErrEmptyContent, "內容不可為空", "content cannot be empty"},
+		{&storage.PathError{Op: storage.OpDataDir, Err: errors.New("boom")}, "獲取資料目錄失敗: boom", "failed to get data directory: boom"},
+		{parse, "解析筆記 a.md 失敗: front matter 的 due 無效: \"明天\"", "failed to parse note a.md: invalid due in front matter: \"明天\""},
+		{&storage.PathError{Op: storage.OpWrite, Path: "/n.md", Err: fs.ErrPermission}, "將筆記寫入檔案 /n.md 失敗: permission denied", "failed to write note to /n.md: permission denied"},
+		{&storage.PathError{Op: storage.OpRename, Path: "/n.md", Err: storage.ErrNoteExists}, "重新命名筆記檔案 /n.md 失敗: 已有同名的筆記檔案", "failed to rename note file /n.md: a note with the same file name already exists"},
+		{&storage.PathError{Op: storage.OpTrash, Path: "/n.md", Err: fs.ErrPermission}, "將筆記檔案 /n.md 移到垃圾桶失敗: permission denied", "failed to move note file /n.md to trash: permission denied"},
+		{&remind.NotFoundError{ID: "bogus"}, "找不到 ID 為 bogus 的提醒，可用 ora remind list 查看", "no reminder with ID bogus; run ora remind list to see reminders"},
+		{&remind.PathError{Op: remind.OpWriteState, Path: "/r.json", Err: fs.ErrPermission}, "寫入提醒狀態檔 /r.json 失敗: permission denied", "failed to write reminder state /r.json: permission denied"},
+		{&remind.PathError{Op: remind.OpStateDir, Err: &storage.PathError{Op: storage.OpMkdir, Path: "/s", Err: fs.ErrPermission}}, "取得提醒狀態目錄失敗: 建立資料夾 /s 失敗: permission denied", "failed to get reminder state directory: failed to create folder /s: permission denied"},
+		{&remind.HookError{Err: errors.New("exit status 3"), Output: "oops\n"}, "執行提醒 hook 失敗: exit status 3: oops", "reminder hook failed: exit status 3: oops"},
+		{errors.Join(remind.ErrInvalidSnooze, &remind.NotFoundError{ID: "x"}), "延後時間必須大於零\n找不到 ID 為 x 的提醒，可用 ora remind list 查看", "snooze duration must be positive\nno reminder with ID x; run ora remind list to see reminders"},
+		{&task.RefError{Ref: "x:2"}, "無效的待辦項目參照 \"x:2\"，請使用 ora task list 列出的參照", "invalid task reference \"x:2\"; use a reference printed by ora task list"},
+		{&task.LineError{Line: 3, Err: task.ErrChanged}, "第 3 行在列出後已被修改，請重新列出待辦項目", "line 3 changed after the tasks were listed; list them again"},
+		{&storage.SkippedNotesError{Errs: []error{parse, storage.ErrEmptyContent}}, "略過 2 篇無法讀取的筆記: 解析筆記 a.md 失敗: front matter 的 due 無效: \"明天\"; 內容不可為空", "skipped 2 unreadable notes: failed to parse note a.md: invalid due in front matter: \"明天\"; content cannot be empty"},
+	}
+	for _, tt := range tests {
+		useLocale(t, ZhTW)
+		assert.Equal(t, tt.zhTW, Error(tt.err))
+		useLocale(t, En)
+		assert.Equal(t, tt.en, Error(tt.err))
+	}
+}
+
+// TestError_Other 測試其他錯誤與被包裝的 storage 錯誤保留原本的文字。
+func TestError_Other(t *testing.T) {
+	assert.Equal(t, "", Error(nil))
+	assert.Equal(t, "boom", Error(errors.New("boom")))
+	wrapped := fmt.Errorf("匯入 a.md: %w", storage.ErrEmptyContent)
+	assert.Equal(t, wrapped.Error(), Error(wrapped))
+}
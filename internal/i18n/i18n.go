@@ -0,0 +1,122 @@
+// Package i18n 提供了 CLI 與 TUI 顯示文字的訊息目錄與語系選擇。
+package i18n
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Locale 是介面文字使用的語系。
+type Locale string
+
+// 支援的語系。
+const (
+	ZhTW Locale = "zh-TW" // 繁體中文（預設）。
+	En   Locale = "en"    // 英文。
+)
+
+// Default 是無法從配置或環境變數判斷語系時使用的語系。
+const Default = ZhTW
+
+// catalogs 是每個語系的訊息目錄，以訊息鍵對應翻譯文字；文字可含 fmt 格式動詞。
+var catalogs = map[Locale]map[string]string{
+	ZhTW: zhTW,
+	En:   en,
+}
+
+// current 是目前使用的語系。
+// AI 心智註解: 語系只在程式啟動時（執行命令或 TUI 之前）設定一次，之後只讀取，因此不加鎖。
+var current = Default
+
+// Locales 返回所有支援的語系。
+func Locales() []Locale {
+	return []Locale{ZhTW, En}
+}
+
+// Set 設定之後 T 使用的語系。
+func Set(l Locale) {
+	current = l
+}
+
+// Current 返回目前使用的語系。
+func Current() Locale {
+	return current
+}
+
+// Parse 將配置或 POSIX 語系字串（例如 zh_TW.UTF-8、en-US）轉換為支援的語系。
+// 中文一律使用繁體中文；不支援的語言返回 false。
+func Parse(s string) (Locale, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	// AI 心智註解: 去除 .UTF-8 編碼與 @modifier，只比對語言代碼。
+	if i := strings.IndexAny(s, ".@"); i >= 0 {
+		s = s[:i]
+	}
+	lang, _, _ := strings.Cut(strings.ReplaceAll(s, "_", "-"), "-")
+	switch lang {
+	case "zh":
+		return ZhTW, true
+	case "en":
+		return En, true
+	}
+	return "", false
+}
+
+// Detect 依配置檔案的 locale 或環境變數判斷語系。
+// 配置的值優先；未設定時依序檢查 LC_ALL、LC_MESSAGES、LANG 中第一個有值的變數，無法判斷時使用 Default。
+// 配置的值不是支援的語系時仍依環境變數判斷，並返回錯誤供呼叫端顯示警告。
+func Detect(configured string) (Locale, error) {
+	var err error
+	if configured != "" {
+		if l, ok := Parse(configured); ok {
+			return l, nil
+		}
+		err = &UnsupportedError{Value: configured}
+	}
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if v := os.Getenv(name); v != "" {
+			// AI 心智註解: 依 POSIX，第一個有值的變數決定語系；C、POSIX 等不支援的值使用預設語系。
+			if l, ok := Parse(v); ok {
+				return l, err
+			}
+			return Default, err
+		}
+	}
+	return Default, err
+}
+
+// UnsupportedError 表示配置的語系不受支援。
+type UnsupportedError struct {
+	Value string
+}
+
+// AI 心智註解: 訊息在顯示時才翻譯，Detect 返回的錯誤會以呼叫端稍後設定的語系顯示。
+func (e *UnsupportedError) Error() string {
+	names := make([]string, 0, len(catalogs))
+	for _, l := range Locales() {
+		names = append(names, string(l))
+	}
+	return T("locale.unsupported", e.Value, strings.Join(names, ", "))
+}
+
+// Lookup 返回目前語系中訊息鍵的原始文字；目前語系缺少時改用預設語系。
+func Lookup(key string) (string, bool) {
+	if s, ok := catalogs[current][key]; ok {
+		return s, true
+	}
+	s, ok := catalogs[Default][key]
+	return s, ok
+}
+
+// T 返回訊息鍵在目前語系中的文字，有參數時以 fmt.Sprintf 套用。
+// 兩種語系都缺少該鍵時返回鍵本身，方便在畫面上發現遺漏的翻譯。
+func T(key string, args ...any) string {
+	s, ok := Lookup(key)
+	if !ok {
+		return key
+	}
+	if len(args) == 0 {
+		return s
+	}
+	return fmt.Sprintf(s, args...)
+}
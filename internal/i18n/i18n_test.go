@@ -0,0 +1,177 @@
+// Package i18n 提供了訊息目錄與語系選擇的單元測試。
+package i18n
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// useLocale 在測試期間切換語系，並在測試結束後還原。
+func useLocale(t *testing.T, l Locale) {
+	old := Current()
+	Set(l)
+	t.Cleanup(func() { Set(old) })
+}
+
+// clearLocaleEnv 清除影響語系判斷的環境變數。
+func clearLocaleEnv(t *testing.T) {
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		t.Setenv(name, "")
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := map[string]Locale{
+		"zh-TW":       ZhTW,
+		"zh_TW.UTF-8": ZhTW,
+		"zh_CN.UTF-8": ZhTW,
+		"en":          En,
+		"en_US.UTF-8": En,
+		"EN-gb":       En,
+		"en_US@euro":  En,
+	}
+	for in, want := range tests {
+		got, ok := Parse(in)
+		assert.True(t, ok, in)
+		assert.Equal(t, want, got, in)
+	}
+	for _, in := range []string{"", "C", "POSIX", "fr_FR.UTF-8"} {
+		_, ok := Parse(in)
+		assert.False(t, ok, in)
+	}
+}
+
+// TestDetect 測試配置優先於環境變數，環境變數依 LC_ALL、LC_MESSAGES、LANG 的順序判斷。
+func TestDetect(t *testing.T) {
+	clearLocaleEnv(t)
+	l, err := Detect("")
+	require.NoError(t, err)
+	assert.Equal(t, Default, l, "沒有任何設定時使用預設語系")
+
+	t.Setenv("LANG", "en_US.UTF-8")
+	l, _ = Detect("")
+	assert.Equal(t, En, l)
+
+	t.Setenv("LC_MESSAGES", "zh_TW.UTF-8")
+	l, _ = Detect("")
+	assert.Equal(t, ZhTW, l, "LC_MESSAGES 優先於 LANG")
+
+	t.Setenv("LC_ALL", "C")
+	l, _ = Detect("")
+	assert.Equal(t, Default, l, "第一個有值的變數不受支援時使用預設語系")
+
+	l, err = Detect("en")
+	require.NoError(t, err)
+	assert.Equal(t, En, l, "配置優先於環境變數")
+}
+
+// TestDetect_Unsupported 測試不支援的配置值返回錯誤並改依環境變數判斷，錯誤訊息以顯示時的語系翻譯。
+func TestDetect_Unsupported(t *testing.T) {
+	clearLocaleEnv(t)
+	t.Setenv("LANG", "en_US.UTF-8")
+	l, err := Detect("klingon")
+	assert.Equal(t, En, l)
+	require.Error(t, err)
+
+	useLocale(t, En)
+	assert.Equal(t, `unsupported locale "klingon" (available: zh-TW, en)`, err.Error())
+	Set(ZhTW)
+	assert.Contains(t, err.Error(), "不支援的語系")
+}
+
+func TestT(t *testing.T) {
+	useLocale(t, En)
+	assert.Equal(t, "Your notes:", T("list.header"))
+	assert.Equal(t, "Updated note \"a\"", T("load.note_updated", "a"))
+	assert.Equal(t, "no.such.key", T("no.such.key"), "缺少翻譯時返回鍵本身")
+
+	// AI 心智註解: 英文目錄缺少的鍵改用預設語系，暫時移除一個鍵來驗證後備。
+	saved := en["list.header"]
+	delete(en, "list.header")
+	t.Cleanup(func() { en["list.header"] = saved })
+	assert.Equal(t, zhTW["list.header"], T("list.header"))
+}
+
+// verbPattern 匹配 fmt 的格式動詞，不含 %%。
+var verbPattern = regexp.MustCompile(`%(\[\d+\])?[-+# 0]*\d*(\.\d*)?[a-zA-Z]`)
+
+// verbs 返回訊息中格式動詞的種類，忽略參數索引與順序，用於比較各語系的翻譯。
+func verbs(s string) []string {
+	var out []string
+	for _, v := range verbPattern.FindAllString(s, -1) {
+		out = append(out, v[len(v)-1:])
+	}
+	slices.Sort(out)
+	return out
+}
+
+// TestCatalogs 測試所有語系的訊息目錄有相同的鍵，且每則翻譯的格式動詞一致。
+func TestCatalogs(t *testing.T) {
+	for _, l := range Locales() {
+		catalog := catalogs[l]
+		for key, s := range zhTW {
+			other, ok := catalog[key]
+			if !assert.True(t, ok, "%s 缺少 %s", l, key) {
+				continue
+			}
+			assert.Equal(t, verbs(s), verbs(other), "%s 的 %s 格式動詞不一致", l, key)
+			assert.NotEmpty(t, strings.TrimSpace(other), "%s 的 %s 為空", l, key)
+		}
+		for key := range catalog {
+			_, ok := zhTW[key]
+			assert.True(t, ok, "%s 有多餘的鍵 %s", l, key)
+		}
+	}
+}
+
+// TestCatalogs_UsedKeys 測試程式碼中以字串常值呼叫 T 與 Lookup 的鍵都存在於訊息目錄。
+func TestCatalogs_UsedKeys(t *testing.T) {
+	root := filepath.Join("..", "..")
+	fset := token.NewFileSet()
+	count := 0
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return err
+		}
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) == 0 {
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok || (sel.Sel.Name != "T" && sel.Sel.Name != "Lookup") {
+				return true
+			}
+			if pkg, ok := sel.X.(*ast.Ident); !ok || pkg.Name != "i18n" {
+				return true
+			}
+			lit, ok := call.Args[0].(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				return true
+			}
+			key, _ := strconv.Unquote(lit.Value)
+			count++
+			_, found := zhTW[key]
+			assert.True(t, found, "%s 使用了不存在的訊息鍵 %q", fset.Position(lit.Pos()), key)
+			return true
+		})
+		return nil
+	})
+	require.NoError(t, err)
+	assert.NotZero(t, count)
+}
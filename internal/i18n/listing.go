@@ -0,0 +1,20 @@
+// Package i18n 提供了 CLI 與 TUI 顯示文字的訊息目錄與語系選擇。
+package i18n
+
+import "github.com/wtg42/ora-ora-ora/internal/listing"
+
+// GroupLabel 以目前語系返回筆記列表分組的組名：日期區間翻譯為今天、本週等，
+// 標籤加上 #，資料夾加上 /，沒有標籤與資料目錄根部的組使用說明文字。
+func GroupLabel(g listing.Grouping, name string) string {
+	switch {
+	case g == listing.GroupDate:
+		return T("order.bucket." + name)
+	case g == listing.GroupTag && name == "":
+		return T("order.untagged")
+	case g == listing.GroupTag:
+		return "#" + name
+	case name == "":
+		return T("order.root")
+	}
+	return name + "/"
+}
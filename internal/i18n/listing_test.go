@@ -0,0 +1,24 @@
+// Package i18n 提供了列表組名翻譯的單元測試。
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wtg42/ora-ora-ora/internal/listing"
+)
+
+func TestGroupLabel(t *testing.T) {
+	useLocale(t, En)
+	assert.Equal(t, "This week", GroupLabel(listing.GroupDate, listing.BucketThisWeek))
+	assert.Equal(t, "#work", GroupLabel(listing.GroupTag, "work"))
+	assert.Equal(t, "Untagged", GroupLabel(listing.GroupTag, ""))
+	assert.Equal(t, "projects/", GroupLabel(listing.GroupFolder, "projects"))
+	assert.Equal(t, "(root)", GroupLabel(listing.GroupFolder, ""))
+
+	// 每個日期區間都有翻譯。
+	for _, b := range []string{listing.BucketToday, listing.BucketYesterday, listing.BucketThisWeek, listing.BucketThisMonth, listing.BucketThisYear, listing.BucketOlder, listing.BucketNever} {
+		_, ok := Lookup("order.bucket." + b)
+		assert.True(t, ok, b)
+	}
+}
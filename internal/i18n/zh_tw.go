@@ -0,0 +1,454 @@
+// Package i18n 提供了 CLI 與 TUI 顯示文字的訊息目錄與語系選擇。
+package i18n
+
+// zhTW 是繁體中文的訊息目錄，也是缺少翻譯時的後備。
+var zhTW = map[string]string{
+	// 語系
+	"locale.unsupported": "不支援的語系 %q（可用 %s）",
+
+	// storage 的錯誤
+	"storage.data_dir":              "獲取資料目錄失敗: %[2]s",
+	"storage.read_dir":              "讀取資料目錄 %s 失敗: %s",
+	"storage.mkdir":                 "建立資料夾 %s 失敗: %s",
+	"storage.read":                  "讀取檔案 %s 失敗: %s",
+	"storage.write":                 "將筆記寫入檔案 %s 失敗: %s",
+	"storage.rename":                "重新命名筆記檔案 %s 失敗: %s",
+	"storage.parse":                 "解析筆記 %s 失敗: %s",
+	"storage.trash":                 "將筆記檔案 %s 移到垃圾桶失敗: %s",
+	"storage.not_found":             "找不到 ID 或標題為 %s 的筆記",
+	"storage.invalid_title":         "標題包含非法字元，無法作為檔案名稱: %s",
+	"storage.invalid_folder":        "資料夾名稱無效: %s",
+	"storage.front_matter":          "front matter 格式錯誤: %v",
+	"storage.front_matter_field":    "front matter 的 %s 無效: %q",
+	"storage.empty_content":         "內容不可為空",
+	"storage.no_path":               "筆記缺少檔案路徑，無法更新",
+	"storage.missing_front_matter":  "檔案格式錯誤：缺少 front matter 起始標記",
+	"storage.unclosed_front_matter": "檔案格式錯誤：缺少 front matter 結束標記",
+	"storage.note_exists":           "已有同名的筆記檔案",
+	"storage.skipped_notes":         "略過 %d 篇無法讀取的筆記: %s",
+
+	// 提醒
+	"remind.not_found":      "找不到 ID 為 %s 的提醒，可用 ora remind list 查看",
+	"remind.invalid_snooze": "延後時間必須大於零",
+	"remind.state_dir":      "取得提醒狀態目錄失敗: %[2]s",
+	"remind.read_state":     "讀取提醒狀態檔 %s 失敗: %s",
+	"remind.parse_state":    "解析提醒狀態檔 %s 失敗: %s",
+	"remind.write_state":    "寫入提醒狀態檔 %s 失敗: %s",
+	"remind.open_log":       "開啟提醒日誌 %s 失敗: %s",
+	"remind.write_log":      "寫入提醒日誌 %s 失敗: %s",
+	"remind.hook_failed":    "執行提醒 hook 失敗: %s: %s",
+	"remind.due_title":      "%s（到期）",
+	"remind.task_title":     "%s（%s）",
+
+	// 待辦項目參照
+	"task.invalid_ref": "無效的待辦項目參照 %q，請使用 ora task list 列出的參照",
+	"task.no_line":     "第 %d 行不存在",
+	"task.not_task":    "第 %d 行不是待辦項目",
+	"task.changed":     "第 %d 行在列出後已被修改，請重新列出待辦項目",
+
+	// TUI 快捷鍵說明
+	"key.up":              "上移",
+	"key.down":            "下移",
+	"key.page_up":         "上一頁",
+	"key.page_down":       "下一頁",
+	"key.open":            "查看",
+	"key.filter":          "篩選",
+	"key.search":          "搜尋",
+	"key.next_match":      "下一個",
+	"key.prev_match":      "上一個",
+	"key.toggle_raw":      "原始碼",
+	"key.preview":         "預覽",
+	"key.back":            "返回",
+	"key.next_field":      "下一欄",
+	"key.prev_field":      "上一欄",
+	"key.new":             "新筆記",
+	"key.edit":            "編輯",
+	"key.tasks":           "待辦事項",
+	"key.toggle_task":     "完成項目",
+	"key.error_log":       "錯誤紀錄",
+	"key.drafts":          "草稿",
+	"key.discard":         "捨棄草稿",
+	"key.help":            "更多說明",
+	"key.quit":            "退出",
+	"key.unknown_action":  "未知的快捷鍵動作 %q",
+	"key.submit":          "送出",
+	"key.newline":         "換行",
+	"key.tag_separator":   "分隔標籤",
+	"key.select_tag":      "選擇標籤",
+	"key.accept_tag":      "補全",
+	"key.apply_filter":    "套用篩選",
+	"key.sort":            "排序",
+	"key.reverse":         "反轉排序",
+	"key.group":           "分組",
+	"key.pin":             "釘選",
+	"key.jump":            "開啟釘選筆記",
+	"key.select_note":     "選取筆記",
+	"key.select_range":    "範圍選取",
+	"key.add_tags":        "加上標籤",
+	"key.remove_tags":     "移除標籤",
+	"key.move":            "移動",
+	"key.trash":           "移到垃圾桶",
+	"key.copy_selection":  "複製選取",
+	"key.yank":            "複製",
+	"key.paste_clipboard": "貼上剪貼簿",
+	"key.apply":           "套用",
+	"key.confirm":         "確認",
+	"key.cancel":          "取消",
+	"key.palette":         "命令面板",
+	"key.run":             "執行",
+	"key.select":          "選擇",
+
+	// TUI 狀態列與錯誤紀錄
+	"status.warning":    "警告: ",
+	"status.error":      "錯誤: ",
+	"status.log_header": "錯誤紀錄:",
+	"status.log_empty":  "沒有錯誤紀錄。",
+
+	// 草稿
+	"draft.autosave_failed": "自動儲存草稿失敗: %s",
+	"draft.load_failed":     "載入草稿失敗: %s",
+	"draft.notice":          "有 %d 份未送出的草稿，按 %s 查看並還原。",
+	"draft.header":          "未送出的草稿:",
+	"draft.empty":           "沒有未送出的草稿。",
+	"draft.untitled":        "（無標題）",
+	"draft.entry":           "%s %s  %s  (%d 字)",
+	"draft.dir_failed":      "獲取草稿目錄失敗: %w",
+	"draft.read_dir_failed": "讀取草稿目錄失敗: %w",
+	"draft.read_failed":     "讀取草稿 %s 失敗: %w",
+	"draft.write_failed":    "寫入草稿 %s 失敗: %w",
+	"draft.delete_failed":   "刪除草稿 %s 失敗: %w",
+
+	// TUI 背景讀取
+	"load.cancel_hint":  "%s（%s 取消）",
+	"load.saving":       "儲存中…",
+	"load.searching":    "搜尋內容…",
+	"load.notes":        "載入筆記…",
+	"load.note":         "開啟筆記…",
+	"load.tasks":        "載入待辦事項…",
+	"load.canceled":     "已取消讀取",
+	"load.note_updated": "已更新筆記「%s」",
+	"load.task_done":    "已完成待辦項目",
+	"load.pinned":       "已釘選「%s」",
+	"load.unpinned":     "已取消釘選「%s」",
+	"load.no_pinned":    "按鍵 %s 沒有對應的釘選筆記",
+
+	// 讀取與儲存失敗
+	"error.find_note":           "尋找筆記失敗: %s",
+	"error.pin":                 "變更釘選狀態失敗: %s",
+	"error.read_note":           "讀取筆記失敗: %s",
+	"error.toggle_task":         "切換待辦項目失敗: %s",
+	"error.save_note":           "儲存筆記失敗: %s",
+	"error.load_notes":          "載入筆記失敗: %s",
+	"error.load_tasks":          "載入待辦事項失敗: %s",
+	"error.edited_note_invalid": "編輯後的筆記無效: %s",
+	"error.search_content":      "搜尋內容失敗: %s",
+	"error.editor":              "編輯器執行失敗: %v",
+	"error.load_views":          "讀取查看紀錄失敗: %v",
+	"error.record_view":         "記錄查看時間失敗: %v",
+	"error.export":              "匯出筆記失敗: %s",
+	"error.open_link":           "無法開啟連結 %s: %v",
+	"error.copy":                "複製到剪貼簿失敗: %v",
+	"error.paste":               "從剪貼簿貼上失敗: %v",
+
+	// 配置檔案
+	"config.load_failed":       "載入配置失敗，改用預設設定: %v",
+	"config.keys_invalid":      "已略過無效的快捷鍵配置，其餘設定照常套用: %v",
+	"config.theme_invalid":     "主題配置無效，改用 %s 主題: %v",
+	"config.layout_invalid":    "未知的版面配置 %q（可用 %s 或 %s），改用 %s",
+	"config.clipboard_invalid": "未知的剪貼簿模式 %q（可用 auto、osc52 或 local），改用 %s",
+	"config.dir_failed":        "獲取配置目錄失敗: %w",
+	"config.parse_failed":      "解析配置檔案 %s 失敗: %w",
+	"config.sort_invalid":      "未知的排序 %q，改用 %s",
+	"config.group_invalid":     "未知的分組 %q，改用 %s",
+
+	// TUI 建立表單
+	"form.title":            "標題: ",
+	"form.tags":             "標籤: ",
+	"form.invalid_tag":      "標籤包含非法字元（%s）: %s",
+	"form.title_required":   "筆記標題不能為空",
+	"form.saved":            "已儲存筆記「%s」(%s)",
+	"form.body_placeholder": "內容…Enter 送出 / Ctrl+J 換行",
+	"form.header":           "建立新筆記:",
+
+	// TUI 列表與篩選
+	"list.invalid_date":       "%s: 日期格式應為 YYYY-MM-DD",
+	"list.error_separator":    "；",
+	"list.filter_placeholder": "標題、#標籤、after:2026-09-01",
+	"list.header":             "您的筆記:",
+	"list.vault":              "筆記庫 %s",
+	"list.loading":            "請稍候…",
+	"list.canceled":           "已取消載入筆記。",
+	"list.empty":              "沒有找到筆記。按下 '%s' 鍵建立新筆記。",
+	"list.no_match":           "沒有符合篩選條件的筆記。",
+	"list.separator":          "、",
+
+	// TUI 詳細視圖
+	"detail.render_failed":      "Markdown 渲染失敗: %v",
+	"detail.search_placeholder": "搜尋筆記內容",
+	"detail.no_match":           "找不到「%s」",
+	"detail.match":              "「%s」%d/%d",
+	"detail.rendered":           "渲染",
+	"detail.raw":                "原始碼",
+	"detail.header":             "筆記內容: %s",
+
+	// TUI 大量貼上
+	"paste.prompt": "貼上內容較大（%d 行、%d 字）：'s' 直接存成筆記，'i' 仍插入輸入區，'esc' 取消。",
+	"paste.title":  "貼上內容 %s",
+	"paste.saved":  "已將貼上內容儲存為筆記「%s」(%s)",
+
+	// TUI 待辦事項
+	"tasks.header": "待辦事項:",
+	"tasks.empty":  "沒有未完成的待辦項目。",
+
+	// TUI 主題
+	"theme.invalid_color":      "%s 的顏色 %q 無效，請使用 0-255 的色號或 #RRGGBB",
+	"theme.color_out_of_range": "%s 的顏色 %q 無效，色號須介於 0 到 255",
+	"theme.unknown":            "未知的主題 %q（可用 %s 或 [themes] 中自訂的名稱）",
+	"theme.invalid_base":       "主題 %q 的 base %q 不是內建主題（可用 %s）",
+	"theme.error":              "主題 %q: %w",
+	"theme.unknown_markdown":   "主題 %q 的 markdown 樣式 %q 不存在",
+
+	// 命令與旗標說明
+	"cmd.ora.short":                       "Ora 是一個 AI 快速筆記應用程式",
+	"cmd.ora.long":                        "Ora 是一個用於快速建立和管理筆記的命令列應用程式，旨在與 AI CLI 代理互動。",
+	"cmd.ora.flag.vault":                  "使用的筆記庫名稱，需定義於配置檔案的 [vaults]；預設為配置檔案的 vault",
+	"cmd.ora.note.short":                  "管理您的筆記",
+	"cmd.ora.note.long":                   "提供用於建立、查看和管理筆記的命令。",
+	"cmd.ora.note.new.short":              "建立一個新筆記",
+	"cmd.ora.note.new.long":               "透過提示輸入標題、內容和可選標籤來互動式地建立一個新筆記。",
+	"cmd.ora.note.edit.short":             "以編輯器開啟筆記",
+	"cmd.ora.note.edit.long":              "以配置檔案中的 editor、$VISUAL 或 $EDITOR 開啟筆記檔案，<id> 可為筆記 ID 或標題。",
+	"cmd.ora.note.list.short":             "列出筆記",
+	"cmd.ora.note.list.long":              "列出所有筆記的日期、ID、標題與標籤，可依建立時間、更新時間、標題、大小或最近查看時間排序，並依日期、標籤或資料夾分組。",
+	"cmd.ora.note.list.flag.sort":         "排序方式：created、updated、title、size 或 viewed，可加上 :asc 或 :desc",
+	"cmd.ora.note.list.flag.group":        "分組方式：none、date、tag 或 folder",
+	"cmd.ora.note.pin.short":              "釘選筆記",
+	"cmd.ora.note.pin.long":               "釘選筆記，釘選的筆記在 TUI 列表中固定顯示在最上方，並可在列表中以 1–9 直接開啟；<id> 可為筆記 ID 或標題。",
+	"cmd.ora.note.unpin.short":            "取消釘選筆記",
+	"cmd.ora.note.unpin.long":             "取消筆記的釘選；<id> 可為筆記 ID 或標題。",
+	"cmd.ora.task.short":                  "管理筆記中的待辦項目",
+	"cmd.ora.task.long":                   "彙整所有筆記中的 Markdown 待辦項目（- [ ]），支援 due:YYYY-MM-DD、@person 與 !high/!medium/!low 標記。",
+	"cmd.ora.task.list.short":             "列出待辦項目",
+	"cmd.ora.task.list.long":              "列出所有筆記中的待辦項目，可依完成狀態與到期日篩選。",
+	"cmd.ora.task.done.short":             "切換待辦項目的完成狀態",
+	"cmd.ora.task.done.long":              "切換待辦項目的核取方塊並寫回來源筆記，<ref> 格式為 <筆記檔案>:<行號>:<校驗碼>，可由 'ora task list' 取得；筆記在列出後被修改時不會勾選。",
+	"cmd.ora.remind.short":                "管理筆記與待辦項目的提醒",
+	"cmd.ora.remind.long":                 "依筆記 front matter 的 remind_at/due 欄位與待辦項目的 due: 標記排程提醒。",
+	"cmd.ora.remind.list.short":           "列出所有提醒",
+	"cmd.ora.remind.daemon.short":         "啟動提醒常駐程序",
+	"cmd.ora.remind.daemon.long":          "定期檢查到期的提醒並送出通知。預設輸出至 stdout，亦可透過 --hook 執行指令或以 --log 寫入日誌檔；已送出的狀態會持久化，重啟後不會重複通知。",
+	"cmd.ora.remind.snooze.short":         "延後提醒",
+	"cmd.ora.remind.snooze.long":          "將提醒延後指定的時間，例如 'ora remind snooze 20261001090000 1h'。<id> 可由 'ora remind list' 取得。",
+	"cmd.ora.import.short":                "從 Obsidian、Joplin 或 Markdown 資料夾匯入筆記",
+	"cmd.ora.import.long":                 "將 Obsidian vault、Joplin RAW 匯出目錄或一般 Markdown 資料夾轉換為 Ora 筆記，保留建立時間、標籤、資料夾、附件與內部連結。重複匯入時會略過已匯入的筆記。",
+	"cmd.ora.export.short":                "匯出筆記",
+	"cmd.ora.export.long":                 "將筆記匯出為 json、jsonl、html（靜態網站）或 md-bundle（單一 Markdown 文件，適合貼進 AI 提示），可依標籤、日期與資料夾篩選。",
+	"cmd.ora.tui.short":                   "啟動 TUI 介面",
+	"cmd.ora.tui.long":                    "啟動互動式終端使用者介面來管理筆記。",
+	"cmd.ora.task.list.flag.open":         "只列出未完成的項目",
+	"cmd.ora.task.list.flag.due-before":   "只列出到期日早於此日期的項目 (YYYY-MM-DD)",
+	"cmd.ora.remind.daemon.flag.interval": "檢查提醒的間隔",
+	"cmd.ora.remind.daemon.flag.hook":     "提醒到期時執行的 shell 指令（可使用 $ORA_REMINDER_ID、$ORA_REMINDER_TITLE、$ORA_REMINDER_AT）",
+	"cmd.ora.remind.daemon.flag.log":      "將提醒附加寫入的日誌檔路徑",
+	"cmd.ora.remind.daemon.flag.quiet":    "不輸出提醒至 stdout",
+	"cmd.ora.export.flag.format":          "匯出格式: json、jsonl、html 或 md-bundle",
+	"cmd.ora.export.flag.output":          "輸出檔案（html 格式為輸出目錄），預設輸出至 stdout",
+	"cmd.ora.export.flag.tag":             "只匯出包含這些標籤的筆記（可重複指定）",
+	"cmd.ora.export.flag.after":           "只匯出此日期（含）之後建立的筆記 (YYYY-MM-DD)",
+	"cmd.ora.export.flag.before":          "只匯出此日期之前建立的筆記 (YYYY-MM-DD)",
+	"cmd.ora.export.flag.folder":          "只匯出此資料夾（含子資料夾）中的筆記",
+
+	// 命令列
+	"cli.welcome":               "歡迎使用 Ora！使用 'ora --help' 獲取更多資訊。",
+	"cli.warning":               "警告: %v",
+	"cli.error":                 "錯誤: %v",
+	"cli.app_error":             "應用程式錯誤: %v",
+	"cli.config_dir_failed":     "獲取配置目錄失敗: %w",
+	"cli.data_dir_failed":       "獲取資料目錄失敗: %w",
+	"cli.config_dir":            "配置目錄: %s",
+	"cli.data_dir":              "資料目錄: %s",
+	"cli.prompt_title":          "輸入筆記標題: ",
+	"cli.prompt_content":        "輸入筆記內容 (按兩次 Enter 結束):",
+	"cli.prompt_tags":           "輸入標籤 (逗號分隔，可選): ",
+	"cli.note_created":          "新筆記已建立:",
+	"cli.note_title":            "標題: %s",
+	"cli.note_content":          "內容: %s",
+	"cli.note_tags":             "標籤: %v",
+	"cli.note_created_at":       "建立時間: %s",
+	"cli.note_saved":            "筆記已成功建立並儲存！",
+	"cli.read_config_failed":    "讀取配置失敗: %v",
+	"cli.editor_command_failed": "建立編輯器指令失敗: %v",
+	"cli.edited_note_invalid":   "編輯後的筆記無效，檔案未被修改: %s",
+	"cli.note_updated":          "筆記已更新: %s (%s)",
+	"cli.pinned":                "已釘選筆記: %s (%s)",
+	"cli.unpinned":              "已取消釘選筆記: %s (%s)",
+	"cli.invalid_date":          "無效的日期 %s，格式應為 YYYY-MM-DD: %v",
+	"cli.no_tasks":              "沒有符合條件的待辦項目。",
+	"cli.task_done":             "已完成: %s",
+	"cli.task_reopened":         "已重新開啟: %s",
+	"cli.no_reminders":          "沒有排定的提醒。",
+	"cli.no_notifier":           "未設定任何通知方式：請移除 --quiet 或指定 --hook/--log",
+	"cli.remind_check_failed":   "提醒檢查失敗: %s",
+	"cli.daemon_failed":         "提醒常駐程序錯誤: %v",
+	"cli.invalid_duration":      "無效的延後時間 %s: %v",
+	"cli.snooze_failed":         "延後提醒失敗: %v",
+	"cli.snoozed":               "提醒 %s 已延後至 %s",
+	"cli.import_failed":         "匯入失敗: %s",
+	"cli.imported":              "已匯入 %d 篇筆記。",
+	"cli.import_skipped":        "略過 %d 篇先前已匯入的筆記。",
+	"cli.import_problems":       "無法轉換的項目 (%d):",
+	"cli.unsupported_format":    "不支援的匯出格式: %s（可用: %v）",
+	"cli.invalid_flag":          "無效的 %s: %v",
+	"cli.html_output_required":  "html 格式需要以 --output 指定輸出目錄",
+	"cli.exported":              "已匯出 %d 篇筆記至 %s",
+	"cli.create_output_failed":  "建立輸出檔案失敗: %v",
+	"cli.export_failed":         "匯出失敗: %s",
+	"cli.tui_error":             "TUI 錯誤: %v",
+	"cli.unknown_vault":         "配置檔案的 [vaults] 中沒有名為 %q 的筆記庫",
+	"cli.invalid_sort":          "無效的排序 %q（可用 %s，可加上 :asc 或 :desc）",
+	"cli.invalid_group":         "無效的分組 %q（可用 %s）",
+	"cli.no_notes":              "目前沒有筆記。",
+
+	// 列表的排序與分組
+	"order.key.created":       "建立時間",
+	"order.key.updated":       "更新時間",
+	"order.key.title":         "標題",
+	"order.key.size":          "大小",
+	"order.key.viewed":        "最近查看",
+	"order.asc":               "↑",
+	"order.desc":              "↓",
+	"order.group.date":        "日期",
+	"order.group.tag":         "標籤",
+	"order.group.folder":      "資料夾",
+	"order.sort_label":        "排序：%s %s",
+	"order.group_label":       "分組：%s",
+	"order.bucket.today":      "今天",
+	"order.bucket.yesterday":  "昨天",
+	"order.bucket.this_week":  "本週",
+	"order.bucket.this_month": "本月",
+	"order.bucket.this_year":  "今年",
+	"order.bucket.older":      "更早",
+	"order.bucket.never":      "未曾查看",
+	"order.untagged":          "未加標籤",
+	"order.root":              "根目錄",
+	"order.pinned":            "釘選",
+
+	// TUI 列表動作
+	"command.open":           "查看選中的筆記",
+	"command.filter":         "篩選與搜尋筆記",
+	"command.new":            "建立新筆記",
+	"command.edit":           "以外部編輯器開啟筆記",
+	"command.tasks":          "開啟待辦事項",
+	"command.drafts":         "開啟草稿",
+	"command.preview":        "切換預覽窗格",
+	"command.export":         "匯出列表或選取的筆記",
+	"command.sort":           "切換排序方式",
+	"command.reverse":        "反轉排序方向",
+	"command.group":          "切換分組方式",
+	"command.pin":            "釘選或取消釘選筆記",
+	"command.select":         "選取或取消選取筆記",
+	"command.select_range":   "開始或結束範圍選取",
+	"command.add_tags":       "為選取的筆記加上標籤",
+	"command.remove_tags":    "從選取的筆記移除標籤",
+	"command.move":           "將選取的筆記移到資料夾",
+	"command.trash":          "將選取的筆記移到垃圾桶",
+	"command.copy_selection": "將選取的筆記複製到剪貼簿",
+	"command.yank":           "複製筆記的內容、標題、路徑或連結",
+	"command.copy_body":      "複製筆記內容",
+	"command.copy_title":     "複製筆記標題",
+	"command.copy_path":      "複製筆記檔案路徑",
+	"command.copy_link":      "複製筆記的 [[連結]]",
+	"command.theme":          "切換主題",
+	"command.error_log":      "開啟錯誤紀錄",
+	"command.quit":           "退出",
+	"command.export_empty":   "列表中沒有可匯出的筆記",
+	"command.exported":       "已匯出 %d 則筆記到 %s",
+	"command.theme_switched": "已切換到 %s 主題",
+	"command.theme_failed":   "無法切換到 %s 主題: %v",
+	"command.vault":          "切換到筆記庫 %s",
+	"command.vault_default":  "預設",
+	"command.vault_switched": "已切換到筆記庫 %s",
+	"command.vault_busy":     "筆記儲存中，完成後再切換筆記庫",
+	"command.mouse":          "切換滑鼠模式",
+
+	// TUI 命令面板
+	"palette.header":      "命令面板",
+	"palette.placeholder": "搜尋動作或筆記標題",
+	"palette.selected":    "選中的筆記：%s",
+	"palette.note":        "筆記",
+	"palette.no_match":    "沒有符合的動作或筆記",
+
+	// TUI 滑鼠
+	"mouse.enabled":        "已啟用滑鼠",
+	"mouse.disabled":       "已停用滑鼠，可直接選取終端中的文字",
+	"mouse.link_not_found": "找不到標題為「%s」的筆記",
+	"mouse.link_opened":    "已在瀏覽器開啟 %s",
+
+	// TUI 多選與批次動作
+	"bulk.selected":           "已選取 %d 則",
+	"bulk.visual":             "-- 範圍選取 %d 則 --",
+	"bulk.add_tags_prompt":    "為 %d 則筆記加上標籤（以逗號分隔）:",
+	"bulk.remove_tags_prompt": "從 %d 則筆記移除標籤（以逗號分隔）:",
+	"bulk.move_prompt":        "將 %d 則筆記移到資料夾（留空為根目錄）:",
+	"bulk.trash_prompt":       "將 %d 則筆記移到垃圾桶？(y/N)",
+	"bulk.tagged":             "已為 %d 則筆記加上標籤",
+	"bulk.untagged":           "已從 %d 則筆記移除標籤",
+	"bulk.moved":              "已移動 %d 則筆記",
+	"bulk.trashed":            "已將 %d 則筆記移到垃圾桶",
+	"bulk.failed":             "%d 則筆記完成，%d 則失敗: %s",
+	"bulk.copied":             "已將 %d 則筆記複製到剪貼簿",
+
+	// TUI 剪貼簿
+	"yank.prompt":          "複製：b 內容、t 標題、p 檔案路徑、l [[連結]]，其他按鍵取消。",
+	"yank.body":            "已複製「%[1]s」的內容",
+	"yank.title":           "已複製標題「%[1]s」",
+	"yank.path":            "已複製檔案路徑 %[2]s",
+	"yank.link":            "已複製連結 %[2]s",
+	"yank.clipboard_empty": "剪貼簿是空的",
+
+	// 匯出
+	"export.json_failed":        "輸出 JSON 失敗: %w",
+	"export.jsonl_failed":       "輸出 JSONL 失敗: %w",
+	"export.markdown_failed":    "輸出 Markdown 失敗: %w",
+	"export.bundle_title":       "Ora 筆記匯出",
+	"export.bundle_count":       "共 %d 篇筆記。",
+	"export.created":            "建立時間",
+	"export.updated":            "更新時間",
+	"export.folder":             "資料夾",
+	"export.tags":               "標籤",
+	"export.html_lang":          "zh-Hant",
+	"export.all_notes":          "所有筆記",
+	"export.tag_index":          "標籤索引",
+	"export.no_tags":            "沒有標籤。",
+	"export.mkdir_failed":       "建立輸出目錄 %s 失敗: %w",
+	"export.render_page_failed": "產生頁面 %s 失敗: %w",
+	"export.write_page_failed":  "寫入頁面 %s 失敗: %w",
+	"export.render_note_failed": "渲染筆記 %s 失敗: %w",
+
+	// 匯入
+	"import.unsupported_format":     "不支援的匯入格式: %s",
+	"import.stat_failed":            "讀取匯入來源 %s 失敗: %w",
+	"import.not_dir":                "匯入來源必須是資料夾: %s",
+	"import.read_source_failed":     "讀取匯入來源失敗: %w",
+	"import.read_entry_failed":      "讀取 %s 失敗: %w",
+	"import.data_dir_failed":        "獲取資料目錄失敗: %w",
+	"import.attachment_dir_failed":  "獲取附件目錄失敗: %w",
+	"import.untitled":               "未命名",
+	"import.existing_unreadable":    "無法讀取既有筆記: %s",
+	"import.save_failed":            "%s: 無法儲存筆記: %s",
+	"import.title_renamed":          "%s: 標題含有非法字元，已改為 %q",
+	"import.front_matter_kept":      "%s: front matter 無法解析，已保留為內文: %v",
+	"import.created_invalid":        "%s: 無法解析建立時間 %v，改用檔案修改時間",
+	"import.created_now":            "%s: 無法解析建立時間，改用目前時間",
+	"import.field_dropped":          "%s: front matter 欄位 %q 未轉換",
+	"import.link_unresolved":        "%s: 無法解析內部連結 %s",
+	"import.link_target_missing":    "%s: 找不到連結目標 :/%s",
+	"import.attachment_missing":     "%s: 找不到附件 %s",
+	"import.attachment_copy_failed": "%s: 複製附件 %s 失敗: %v",
+	"import.resource_copy_failed":   "%s: 複製資源 %s 失敗: %v",
+	"import.not_joplin":             "joplin:%s: 不是 Joplin 匯出項目，已略過",
+	"import.unsupported_type":       "joplin:%s: 不支援的項目類型 %s，已略過",
+
+	// 外部編輯器
+	"editor.not_set": "未設定編輯器",
+}
@@ -0,0 +1,320 @@
+// Package importer 將 Obsidian vault、Joplin 匯出目錄與一般 Markdown 資料夾轉換為 Ora 筆記。
+package importer
+
+import (
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// wikiPattern 匹配 Obsidian 的 [[連結]] 與 ![[嵌入]]。
+	wikiPattern = regexp.MustCompile(`(!?)\[\[([^\[\]]+)\]\]`)
+	// mdLinkPattern 匹配標準 Markdown 的 [文字](目標) 與 ![替代文字](目標)。
+	mdLinkPattern = regexp.MustCompile(`(!?)\[([^\]]*)\]\(([^)\s]+)\)`)
+	// inlineTagPattern 匹配內文中的 #標籤。
+	inlineTagPattern = regexp.MustCompile(`(?:^|\s)#([\p{L}\p{N}_/-]+)`)
+)
+
+// timeLayouts 是 front matter 時間欄位可接受的格式。
+var timeLayouts = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02"}
+
+// folderConverter 匯入以資料夾組織的 Markdown 筆記，Obsidian vault 額外支援 wiki 連結與內文標籤。
+type folderConverter struct {
+	format      Format
+	obsidian    bool              // 是否處理 [[wiki 連結]]、![[嵌入]] 與內文 #標籤。
+	root        string            // 來源根目錄。
+	byPath      map[string]*draft // 小寫的相對路徑（不含 .md）→ 筆記。
+	byName      map[string]*draft // 小寫的檔名（不含 .md）→ 筆記。
+	attachments map[string]string // 小寫的附件檔名 → 檔案路徑。
+}
+
+// newFolderConverter 建立資料夾格式的 converter。
+func newFolderConverter(format Format, obsidian bool) *folderConverter {
+	return &folderConverter{
+		format:      format,
+		obsidian:    obsidian,
+		byPath:      map[string]*draft{},
+		byName:      map[string]*draft{},
+		attachments: map[string]string{},
+	}
+}
+
+// scan 走訪來源資料夾，略過以 . 開頭的資料夾（例如 .obsidian 與 .trash）。
+func (c *folderConverter) scan(root string, rep *Report) ([]*draft, error) {
+	c.root = root
+	var drafts []*draft
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if p != root && strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if !strings.EqualFold(path.Ext(rel), ".md") {
+			if _, ok := c.attachments[strings.ToLower(d.Name())]; !ok {
+				c.attachments[strings.ToLower(d.Name())] = p
+			}
+			return nil
+		}
+
+		dr, err := c.readDraft(p, rel, rep)
+		if err != nil {
+			return err
+		}
+		drafts = append(drafts, dr)
+		key := strings.ToLower(strings.TrimSuffix(rel, path.Ext(rel)))
+		c.byPath[key] = dr
+		if _, ok := c.byName[path.Base(key)]; !ok {
+			c.byName[path.Base(key)] = dr
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("import.read_source_failed"), err)
+	}
+	sortDrafts(drafts)
+	return drafts, nil
+}
+
+// readDraft 讀取單一 Markdown 檔案並解析其 front matter。
+func (c *folderConverter) readDraft(p, rel string, rep *Report) (*draft, error) {
+	data, err := os.ReadFile(p)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(p)
+	if err != nil {
+		return nil, err
+	}
+	source := fmt.Sprintf("%s:%s", c.format, rel)
+	d := &draft{
+		source:  source,
+		srcPath: p,
+		title:   strings.TrimSuffix(path.Base(rel), path.Ext(rel)),
+		folder:  path.Dir(rel),
+		created: info.ModTime(),
+	}
+	if d.folder == "." {
+		d.folder = ""
+	}
+
+	meta, body := splitYAML(string(data))
+	d.body = body
+	var fmTags []string
+	if meta != "" {
+		fields := map[string]any{}
+		if err := yaml.Unmarshal([]byte(meta), &fields); err != nil {
+			rep.problem(i18n.T("import.front_matter_kept", source, err))
+			d.body = string(data)
+		}
+		for key, value := range fields {
+			switch strings.ToLower(key) {
+			case "title":
+				if s, ok := value.(string); ok && s != "" {
+					d.title = s
+				}
+			case "tags", "tag":
+				fmTags = toStrings(value)
+			case "created", "created_at", "date", "creation_date":
+				if t, ok := parseTimeValue(value); ok {
+					d.created = t
+				} else {
+					rep.problem(i18n.T("import.created_invalid", source, value))
+				}
+			case "updated", "updated_at", "modified":
+				if t, ok := parseTimeValue(value); ok {
+					d.updated = t
+				}
+			default:
+				rep.problem(i18n.T("import.field_dropped", source, key))
+			}
+		}
+	}
+
+	var inlineTags []string
+	if c.obsidian {
+		for _, m := range inlineTagPattern.FindAllStringSubmatch(d.body, -1) {
+			// AI 心智註解: Obsidian 不把純數字（例如 #1）視為標籤。
+			if strings.IndexFunc(m[1], func(r rune) bool { return !unicode.IsDigit(r) }) >= 0 {
+				inlineTags = append(inlineTags, m[1])
+			}
+		}
+	}
+	d.tags = mergeTags(fmTags, inlineTags)
+	return d, nil
+}
+
+// convert 將連結與附件轉換為 Ora 格式。
+func (c *folderConverter) convert(d *draft, att *attacher, rep *Report) string {
+	// AI 心智註解: 先處理標準 Markdown 連結，避免 wiki 嵌入轉出的附件連結被再次處理。
+	body := mdLinkPattern.ReplaceAllStringFunc(d.body, func(match string) string {
+		m := mdLinkPattern.FindStringSubmatch(match)
+		return c.convertMarkdownLink(d, m[1] == "!", m[2], m[3], match, att, rep)
+	})
+	if !c.obsidian {
+		return body
+	}
+	return wikiPattern.ReplaceAllStringFunc(body, func(match string) string {
+		m := wikiPattern.FindStringSubmatch(match)
+		return c.convertWiki(d, m[1] == "!", m[2], match, att, rep)
+	})
+}
+
+// convertWiki 轉換單一 [[連結]] 或 ![[嵌入]]。
+func (c *folderConverter) convertWiki(d *draft, embed bool, inner, original string, att *attacher, rep *Report) string {
+	target, _, _ := strings.Cut(inner, "|")
+	name, _, _ := strings.Cut(target, "#")
+	name = strings.TrimSpace(name)
+	if name == "" {
+		// AI 心智註解: [[#標題]] 指向同一篇筆記內的段落，Ora 沒有對應格式，保留原文。
+		return original
+	}
+
+	ext := strings.ToLower(path.Ext(name))
+	if embed && ext != "" && ext != ".md" {
+		src, ok := c.findAttachment(name)
+		if !ok {
+			rep.problem(i18n.T("import.attachment_missing", d.source, name))
+			return original
+		}
+		return c.attachLink(d, true, path.Base(name), src, original, att, rep)
+	}
+
+	if target := c.findNote(name); target != nil {
+		return note.Link(target.title)
+	}
+	rep.problem(i18n.T("import.link_unresolved", d.source, original))
+	return original
+}
+
+// convertMarkdownLink 轉換指向本地筆記或附件的標準 Markdown 連結，外部網址保持不變。
+func (c *folderConverter) convertMarkdownLink(d *draft, image bool, text, target, original string, att *attacher, rep *Report) string {
+	if strings.Contains(target, "://") || strings.HasPrefix(target, "mailto:") || strings.HasPrefix(target, "#") {
+		return original
+	}
+	decoded, err := url.PathUnescape(target)
+	if err != nil {
+		decoded = target
+	}
+	decoded, _, _ = strings.Cut(decoded, "#")
+	local := filepath.Join(filepath.Dir(d.srcPath), filepath.FromSlash(decoded))
+
+	if strings.EqualFold(path.Ext(decoded), ".md") {
+		rel, err := filepath.Rel(c.root, local)
+		if err == nil {
+			key := strings.ToLower(strings.TrimSuffix(filepath.ToSlash(rel), path.Ext(rel)))
+			if target, ok := c.byPath[key]; ok {
+				return note.Link(target.title)
+			}
+		}
+		rep.problem(i18n.T("import.link_unresolved", d.source, original))
+		return original
+	}
+
+	if _, err := os.Stat(local); err != nil {
+		rep.problem(i18n.T("import.attachment_missing", d.source, decoded))
+		return original
+	}
+	return c.attachLink(d, image, text, local, original, att, rep)
+}
+
+// attachLink 複製附件並返回指向它的 Markdown 連結。
+func (c *folderConverter) attachLink(d *draft, image bool, text, src, original string, att *attacher, rep *Report) string {
+	rel, err := att.attach(src, filepath.Base(src), d.folder)
+	if err != nil {
+		rep.problem(i18n.T("import.attachment_copy_failed", d.source, src, err))
+		return original
+	}
+	prefix := ""
+	if image {
+		prefix = "!"
+	}
+	return fmt.Sprintf("%s[%s](%s)", prefix, text, rel)
+}
+
+// findNote 依相對路徑或檔名尋找筆記，與 Obsidian 的連結解析方式相同。
+func (c *folderConverter) findNote(name string) *draft {
+	key := strings.ToLower(strings.TrimSuffix(name, ".md"))
+	if d, ok := c.byPath[key]; ok {
+		return d
+	}
+	return c.byName[path.Base(key)]
+}
+
+// findAttachment 依相對路徑或檔名尋找附件檔案。
+func (c *folderConverter) findAttachment(name string) (string, bool) {
+	p := filepath.Join(c.root, filepath.FromSlash(name))
+	if _, err := os.Stat(p); err == nil {
+		return p, true
+	}
+	p, ok := c.attachments[strings.ToLower(path.Base(name))]
+	return p, ok
+}
+
+// splitYAML 將以 --- 開頭的 front matter 與內文分開；沒有 front matter 時返回空字串與原內容。
+func splitYAML(content string) (string, string) {
+	normalized := strings.ReplaceAll(content, "\r\n", "\n")
+	if !strings.HasPrefix(normalized, "---\n") {
+		return "", content
+	}
+	rest := normalized[4:]
+	end := strings.Index(rest, "\n---")
+	if end == -1 {
+		return "", content
+	}
+	meta := rest[:end]
+	body := rest[end+4:]
+	body = strings.TrimPrefix(body, "-") // 容許 ---- 之類的結束標記。
+	body = strings.TrimLeft(body, "\n")
+	return meta, body
+}
+
+// toStrings 將 YAML 中的清單或以逗號、空白分隔的字串轉為字串切片。
+func toStrings(value any) []string {
+	switch v := value.(type) {
+	case string:
+		return strings.FieldsFunc(v, func(r rune) bool { return r == ',' || unicode.IsSpace(r) })
+	case []any:
+		var result []string
+		for _, item := range v {
+			result = append(result, fmt.Sprint(item))
+		}
+		return result
+	}
+	return nil
+}
+
+// parseTimeValue 解析 YAML 中的時間值，支援 time.Time 與多種字串格式。
+func parseTimeValue(value any) (time.Time, bool) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, true
+	case string:
+		for _, layout := range timeLayouts {
+			if t, err := time.ParseInLocation(layout, strings.TrimSpace(v), time.Local); err == nil {
+				return t, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
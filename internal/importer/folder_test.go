@@ -0,0 +1,63 @@
+// Package importer 提供了 Obsidian vault 匯入的單元測試。
+package importer
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+)
+
+// TestImport_Obsidian 測試 Obsidian 的 front matter、標籤、wiki 連結與嵌入附件轉換。
+func TestImport_Obsidian(t *testing.T) {
+	setupTestDataHome(t)
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		".obsidian/app.json": "{}",
+		"Daily/2024-01-02.md": "---\ncreated: 2024-01-02 08:30\ntags:\n  - journal\naliases: [jan2]\n---\n" +
+			"今天讀了 [[Projects/Ora|Ora 專案]] 和 [[Missing]]。 #reading #1\n![[diagram.png]]",
+		"Projects/Ora.md":    "Ora 是筆記工具 [[2024-01-02#早上]]",
+		"Projects/What?.md":  "標題有問號",
+		"assets/diagram.png": "PNG",
+	})
+
+	rep, err := Import(FormatObsidian, root)
+	require.NoError(t, err)
+	assert.Len(t, rep.Imported, 3)
+
+	notes := notesByTitle(t)
+	daily := notes["2024-01-02"]
+	require.NotNil(t, daily)
+	assert.Equal(t, "Daily", daily.Folder)
+	assert.Equal(t, "2024-01-02 08:30", daily.CreatedAt.Format("2006-01-02 15:04"))
+	assert.Equal(t, []string{"journal", "reading"}, daily.Tags)
+	assert.Equal(t, "今天讀了 [[Ora]] 和 [[Missing]]。 #reading #1\n![diagram.png](../../attachments/diagram.png)", daily.Content)
+	assert.Equal(t, "Ora 是筆記工具 [[2024-01-02]]", notes["Ora"].Content)
+	assert.NotNil(t, notes["What_"])
+
+	attachments, err := storage.GetAppDataSubDir("attachments")
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(attachments, "diagram.png"))
+	assert.NoError(t, err)
+
+	// 報告應列出無法轉換的欄位、連結與改名。
+	assert.Len(t, rep.Problems, 3)
+	assertProblem(t, rep, `Daily/2024-01-02.md: front matter 欄位 "aliases" 未轉換`)
+	assertProblem(t, rep, "Daily/2024-01-02.md: 無法解析內部連結 [[Missing]]")
+	assertProblem(t, rep, `What?.md: 標題含有非法字元，已改為 "What_"`)
+}
+
+// assertProblem 斷言報告中有包含指定文字的問題。
+func assertProblem(t *testing.T, rep *Report, substr string) {
+	t.Helper()
+	for _, p := range rep.Problems {
+		if strings.Contains(p, substr) {
+			return
+		}
+	}
+	t.Errorf("報告中找不到 %q: %v", substr, rep.Problems)
+}
@@ -0,0 +1,288 @@
+// Package importer 將 Obsidian vault、Joplin 匯出目錄與一般 Markdown 資料夾轉換為 Ora 筆記。
+package importer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+)
+
+// Format 代表匯入來源的格式。
+type Format string
+
+const (
+	FormatObsidian Format = "obsidian" // Obsidian vault。
+	FormatJoplin   Format = "joplin"   // Joplin 的 RAW 匯出目錄。
+	FormatMarkdown Format = "markdown" // 一般 Markdown 資料夾。
+)
+
+// Formats 列出所有支援的匯入格式。
+var Formats = []Format{FormatObsidian, FormatJoplin, FormatMarkdown}
+
+// illegalChars 是無法出現在 Ora 檔案名稱中的字元，與 storage 的驗證一致。
+const illegalChars = "/\\:*?\"<>|"
+
+// Report 記錄一次匯入的結果。
+type Report struct {
+	Imported []string // 成功匯入的筆記標題。
+	Skipped  []string // 先前已匯入而略過的來源。
+	Problems []string // 無法轉換的項目說明。
+}
+
+// problem 新增一筆無法轉換的項目說明，msg 應已依目前語系翻譯。
+func (r *Report) problem(msg string) {
+	r.Problems = append(r.Problems, msg)
+}
+
+// draft 是匯入過程中的中介筆記，在所有標題確定後才轉換內容並寫入。
+type draft struct {
+	source  string    // 來源識別碼，寫入 front matter 的 source 欄位。
+	srcPath string    // 原始檔案路徑，用於解析相對路徑的附件。
+	title   string    // 最終標題。
+	folder  string    // 最終資料夾，以 / 分隔。
+	body    string    // 原始內容。
+	tags    []string  // 標籤。
+	created time.Time // 原始建立時間。
+	updated time.Time // 原始更新時間，可能為零值。
+	skip    bool      // 先前已匯入，不再寫入。
+}
+
+// converter 定義各來源格式的掃描與內容轉換。
+type converter interface {
+	// scan 讀取來源目錄並返回所有中介筆記。
+	scan(root string, rep *Report) ([]*draft, error)
+	// convert 將中介筆記的內容轉換為 Ora 格式，包含內部連結與附件。
+	convert(d *draft, att *attacher, rep *Report) string
+}
+
+// newConverter 根據格式建立對應的 converter。
+func newConverter(format Format) (converter, error) {
+	switch format {
+	case FormatObsidian:
+		return newFolderConverter(FormatObsidian, true), nil
+	case FormatMarkdown:
+		return newFolderConverter(FormatMarkdown, false), nil
+	case FormatJoplin:
+		return newJoplinConverter(), nil
+	}
+	return nil, errors.New(i18n.T("import.unsupported_format", format))
+}
+
+// Import 從 root 匯入指定格式的筆記，並返回匯入報告。
+// 來源識別碼已存在於資料目錄的筆記會被略過，因此可安全地重複匯入。
+func Import(format Format, root string) (*Report, error) {
+	conv, err := newConverter(format)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("import.stat_failed"), root, err)
+	}
+	if !info.IsDir() {
+		return nil, errors.New(i18n.T("import.not_dir", root))
+	}
+
+	rep := &Report{}
+	drafts, err := conv.scan(root, rep)
+	if err != nil {
+		return nil, err
+	}
+
+	// AI 心智註解: 無法讀取的既有筆記不參與重複判斷，記錄為問題後繼續匯入其餘項目。
+	existing, err := storage.LoadAllNotes()
+	var skipped *storage.SkippedNotesError
+	if errors.As(err, &skipped) {
+		for _, e := range skipped.Errs {
+			rep.problem(i18n.T("import.existing_unreadable", i18n.Error(e)))
+		}
+	} else if err != nil {
+		return nil, err
+	}
+	bySource := map[string]*note.Note{}
+	usedIDs := map[string]bool{}
+	for _, n := range existing {
+		if n.Source != "" {
+			bySource[n.Source] = n
+		}
+		usedIDs[n.ID()] = true
+	}
+
+	// AI 心智註解: 先決定所有標題與 ID，內部連結才能指向最終的標題。
+	for _, d := range drafts {
+		if n, ok := bySource[d.source]; ok {
+			d.skip = true
+			d.title = n.Title
+			rep.Skipped = append(rep.Skipped, d.source)
+			continue
+		}
+		d.title = sanitizeTitle(d.title, d, rep)
+		d.folder = sanitizeFolder(d.folder)
+		for usedIDs[d.created.Format(note.IDLayout)] {
+			// AI 心智註解: 同一秒建立的筆記會共用 ID，往後推一秒以保持 ID 唯一。
+			d.created = d.created.Add(time.Second)
+		}
+		usedIDs[d.created.Format(note.IDLayout)] = true
+	}
+
+	att, err := newAttacher()
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range drafts {
+		if d.skip {
+			continue
+		}
+		n := &note.Note{
+			Title:     d.title,
+			Content:   conv.convert(d, att, rep),
+			Tags:      d.tags,
+			CreatedAt: d.created,
+			UpdatedAt: d.updated,
+			Source:    d.source,
+			Folder:    d.folder,
+		}
+		if err := storage.SaveNote(n); err != nil {
+			rep.problem(i18n.T("import.save_failed", d.source, i18n.Error(err)))
+			continue
+		}
+		rep.Imported = append(rep.Imported, n.Title)
+	}
+	return rep, nil
+}
+
+// sanitizeTitle 將標題中的非法字元替換為底線，並在報告中記錄改名。
+func sanitizeTitle(title string, d *draft, rep *Report) string {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		title = i18n.T("import.untitled")
+	}
+	cleaned := replaceIllegal(title)
+	if cleaned != title {
+		rep.problem(i18n.T("import.title_renamed", d.source, cleaned))
+	}
+	return cleaned
+}
+
+// sanitizeFolder 清理資料夾路徑中每一層的非法字元與開頭的點。
+func sanitizeFolder(folder string) string {
+	var parts []string
+	for _, part := range strings.Split(folder, "/") {
+		part = strings.TrimLeft(replaceIllegal(strings.TrimSpace(part)), ".")
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+// replaceIllegal 將非法檔名字元替換為底線。
+func replaceIllegal(s string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(illegalChars, r) {
+			return '_'
+		}
+		return r
+	}, s)
+}
+
+// mergeTags 合併標籤並移除重複、空白與開頭的 #，保持原始順序。
+func mergeTags(groups ...[]string) []string {
+	var tags []string
+	seen := map[string]bool{}
+	for _, group := range groups {
+		for _, tag := range group {
+			tag = strings.TrimPrefix(strings.TrimSpace(tag), "#")
+			if tag == "" || seen[tag] {
+				continue
+			}
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// attacher 將附件複製到 Ora 的附件目錄，並產生相對於筆記位置的連結路徑。
+type attacher struct {
+	dataDir string            // 筆記資料目錄。
+	dir     string            // 附件目錄。
+	copied  map[string]string // 原始檔案路徑 → 已複製的目標路徑。
+}
+
+// newAttacher 建立 attacher 並確保附件目錄存在。
+func newAttacher() (*attacher, error) {
+	dataDir, err := storage.GetDataDir()
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("import.data_dir_failed"), err)
+	}
+	dir, err := storage.GetAppDataSubDir("attachments")
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("import.attachment_dir_failed"), err)
+	}
+	return &attacher{dataDir: dataDir, dir: dir, copied: map[string]string{}}, nil
+}
+
+// attach 以 name 為檔名複製附件，並返回從筆記所在資料夾指向附件的相對路徑（以 / 分隔）。
+// 內容相同的同名檔案會被重用，因此重複匯入不會產生多份附件。
+func (a *attacher) attach(src, name, folder string) (string, error) {
+	dest, ok := a.copied[src]
+	if !ok {
+		data, err := os.ReadFile(src)
+		if err != nil {
+			return "", err
+		}
+		dest, err = a.destination(name, data)
+		if err != nil {
+			return "", err
+		}
+		a.copied[src] = dest
+	}
+	noteDir := filepath.Join(a.dataDir, filepath.FromSlash(folder))
+	rel, err := filepath.Rel(noteDir, dest)
+	if err != nil {
+		return "", err
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+// destination 為附件挑選目標路徑並寫入；同名但內容不同時加上數字後綴。
+func (a *attacher) destination(name string, data []byte) (string, error) {
+	ext := filepath.Ext(name)
+	stem := strings.TrimSuffix(name, ext)
+	for i := 0; ; i++ {
+		candidate := name
+		if i > 0 {
+			candidate = fmt.Sprintf("%s-%d%s", stem, i, ext)
+		}
+		dest := filepath.Join(a.dir, candidate)
+		existing, err := os.ReadFile(dest)
+		if err == nil {
+			if bytes.Equal(existing, data) {
+				return dest, nil
+			}
+			continue
+		}
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+		if err := os.WriteFile(dest, data, 0644); err != nil {
+			return "", err
+		}
+		return dest, nil
+	}
+}
+
+// sortDrafts 依來源識別碼排序，讓匯入結果與報告順序穩定。
+func sortDrafts(drafts []*draft) {
+	sort.Slice(drafts, func(i, j int) bool { return drafts[i].source < drafts[j].source })
+}
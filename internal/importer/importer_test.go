@@ -0,0 +1,87 @@
+// Package importer 提供了匯入共用流程的單元測試。
+package importer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+)
+
+// setupTestDataHome 將資料目錄指向臨時目錄，並在測試結束後還原。
+func setupTestDataHome(t *testing.T) {
+	old := storage.GetTestDataHome()
+	storage.SetTestDataHome(t.TempDir())
+	t.Cleanup(func() { storage.SetTestDataHome(old) })
+}
+
+// writeFiles 在 root 下依相對路徑建立測試檔案。
+func writeFiles(t *testing.T, root string, files map[string]string) {
+	for rel, content := range files {
+		p := filepath.Join(root, filepath.FromSlash(rel))
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+		require.NoError(t, os.WriteFile(p, []byte(content), 0644))
+	}
+}
+
+// notesByTitle 載入所有筆記並以標題建立索引。
+func notesByTitle(t *testing.T) map[string]*note.Note {
+	notes, err := storage.LoadAllNotes()
+	require.NoError(t, err)
+	result := map[string]*note.Note{}
+	for _, n := range notes {
+		result[n.Title] = n
+	}
+	return result
+}
+
+// TestImport_UnknownFormat 測試不支援的格式會返回錯誤。
+func TestImport_UnknownFormat(t *testing.T) {
+	_, err := Import("evernote", t.TempDir())
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "不支援的匯入格式")
+}
+
+// TestImport_MarkdownFolder 測試一般 Markdown 資料夾的匯入、相對連結與重複匯入。
+func TestImport_MarkdownFolder(t *testing.T) {
+	setupTestDataHome(t)
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		"index.md":        "---\ntitle: 首頁\ndate: 2021-05-06\ntags: [home, docs]\n---\n見 [指南](guide/how%20to.md) 與 ![圖](img/pic.png)",
+		"guide/how to.md": "步驟: 1, 2, 3",
+		"img/pic.png":     "PNG",
+		"empty.md":        "",
+	})
+
+	rep, err := Import(FormatMarkdown, root)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"首頁", "how to"}, rep.Imported)
+	assert.Len(t, rep.Problems, 1)
+	assert.Contains(t, rep.Problems[0], "markdown:empty.md")
+
+	notes := notesByTitle(t)
+	index := notes["首頁"]
+	require.NotNil(t, index)
+	assert.Equal(t, "2021-05-06", index.CreatedAt.Format("2006-01-02"))
+	assert.Equal(t, []string{"home", "docs"}, index.Tags)
+	assert.Equal(t, "markdown:index.md", index.Source)
+	assert.Equal(t, "見 [[how to]] 與 ![圖](../attachments/pic.png)", index.Content)
+	assert.Equal(t, "guide", notes["how to"].Folder)
+
+	// 重複匯入時應略過已匯入的筆記。
+	rep, err = Import(FormatMarkdown, root)
+	require.NoError(t, err)
+	assert.Empty(t, rep.Imported)
+	assert.Len(t, rep.Skipped, 2)
+	assert.Len(t, notesByTitle(t), 2)
+}
+
+// TestSanitizeFolder 測試資料夾路徑的清理。
+func TestSanitizeFolder(t *testing.T) {
+	assert.Equal(t, "a_b/c", sanitizeFolder("a:b/./.c"))
+	assert.Equal(t, "", sanitizeFolder(""))
+}
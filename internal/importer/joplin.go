@@ -0,0 +1,208 @@
+// Package importer 將 Obsidian vault、Joplin 匯出目錄與一般 Markdown 資料夾轉換為 Ora 筆記。
+package importer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+)
+
+// Joplin 項目類型，對應 RAW 匯出檔中的 type_ 欄位。
+const (
+	joplinNote     = "1"
+	joplinFolder   = "2"
+	joplinResource = "4"
+	joplinTag      = "5"
+	joplinNoteTag  = "6"
+)
+
+var (
+	// joplinMetaPattern 匹配 RAW 匯出檔結尾的 key: value 元資料行。
+	joplinMetaPattern = regexp.MustCompile(`^([a-z_]+):\s?(.*)$`)
+	// joplinLinkPattern 匹配指向其他筆記或資源的 Joplin 連結，例如 [文字](:/0123...)。
+	joplinLinkPattern = regexp.MustCompile(`(!?)\[([^\]]*)\]\(:/([0-9a-f]{32})\)`)
+)
+
+// joplinItem 是 RAW 匯出目錄中的單一項目。
+type joplinItem struct {
+	title string
+	body  string
+	meta  map[string]string
+}
+
+// joplinConverter 匯入 Joplin 的 RAW 匯出目錄（每個項目一個 <id>.md 檔，資源位於 resources/）。
+type joplinConverter struct {
+	root      string
+	notes     map[string]*draft      // 筆記 ID → 筆記。
+	resources map[string]*joplinItem // 資源 ID → 資源項目。
+}
+
+// newJoplinConverter 建立 Joplin 格式的 converter。
+func newJoplinConverter() *joplinConverter {
+	return &joplinConverter{
+		notes:     map[string]*draft{},
+		resources: map[string]*joplinItem{},
+	}
+}
+
+// scan 讀取所有項目，組合資料夾階層與標籤後返回筆記。
+func (c *joplinConverter) scan(root string, rep *Report) ([]*draft, error) {
+	c.root = root
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("import.read_source_failed"), err)
+	}
+
+	items := map[string]*joplinItem{}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".md") {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(root, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("import.read_entry_failed"), entry.Name(), err)
+		}
+		item := parseJoplinItem(string(data))
+		if item.meta["id"] == "" || item.meta["type_"] == "" {
+			rep.problem(i18n.T("import.not_joplin", entry.Name()))
+			continue
+		}
+		items[item.meta["id"]] = item
+	}
+
+	tagNames := map[string]string{}
+	noteTags := map[string][]string{}
+	for id, item := range items {
+		switch item.meta["type_"] {
+		case joplinTag:
+			tagNames[id] = item.title
+		case joplinResource:
+			c.resources[id] = item
+		}
+	}
+	for _, item := range items {
+		if item.meta["type_"] == joplinNoteTag {
+			noteTags[item.meta["note_id"]] = append(noteTags[item.meta["note_id"]], tagNames[item.meta["tag_id"]])
+		}
+	}
+
+	var drafts []*draft
+	for id, item := range items {
+		switch item.meta["type_"] {
+		case joplinNote:
+		case joplinFolder, joplinResource, joplinTag, joplinNoteTag:
+			continue
+		default:
+			rep.problem(i18n.T("import.unsupported_type", id, item.meta["type_"]))
+			continue
+		}
+
+		d := &draft{
+			source: "joplin:" + id,
+			title:  item.title,
+			folder: joplinFolderPath(items, item.meta["parent_id"]),
+			body:   item.body,
+			tags:   mergeTags(noteTags[id]),
+		}
+		created, ok := parseJoplinTime(item.meta["user_created_time"], item.meta["created_time"])
+		if !ok {
+			rep.problem(i18n.T("import.created_now", d.source))
+			created = time.Now()
+		}
+		d.created = created
+		d.updated, _ = parseJoplinTime(item.meta["user_updated_time"], item.meta["updated_time"])
+		c.notes[id] = d
+		drafts = append(drafts, d)
+	}
+	sortDrafts(drafts)
+	return drafts, nil
+}
+
+// convert 將指向筆記的連結轉為 [[標題]]，並複製資源附件。
+func (c *joplinConverter) convert(d *draft, att *attacher, rep *Report) string {
+	return joplinLinkPattern.ReplaceAllStringFunc(d.body, func(match string) string {
+		m := joplinLinkPattern.FindStringSubmatch(match)
+		image, text, id := m[1] == "!", m[2], m[3]
+
+		if target, ok := c.notes[id]; ok {
+			return note.Link(target.title)
+		}
+		res, ok := c.resources[id]
+		if !ok {
+			rep.problem(i18n.T("import.link_target_missing", d.source, id))
+			return match
+		}
+		src := filepath.Join(c.root, "resources", id+"."+res.meta["file_extension"])
+		// AI 心智註解: Joplin 資源以 ID 命名，改用資源標題作為附件檔名以便辨識。
+		name := replaceIllegal(res.title)
+		if name == "" || filepath.Ext(name) == "" {
+			name = filepath.Base(src)
+		}
+		rel, err := att.attach(src, name, d.folder)
+		if err != nil {
+			rep.problem(i18n.T("import.resource_copy_failed", d.source, res.title, err))
+			return match
+		}
+		prefix := ""
+		if image {
+			prefix = "!"
+		}
+		return fmt.Sprintf("%s[%s](%s)", prefix, text, rel)
+	})
+}
+
+// parseJoplinItem 解析 RAW 匯出檔：第一行為標題，空行後為內文，結尾為 key: value 元資料。
+func parseJoplinItem(content string) *joplinItem {
+	lines := strings.Split(strings.TrimRight(strings.ReplaceAll(content, "\r\n", "\n"), "\n"), "\n")
+	i := len(lines) - 1
+	for i >= 0 && joplinMetaPattern.MatchString(lines[i]) {
+		i--
+	}
+
+	item := &joplinItem{meta: map[string]string{}}
+	for _, line := range lines[i+1:] {
+		m := joplinMetaPattern.FindStringSubmatch(line)
+		item.meta[m[1]] = m[2]
+	}
+
+	rest := lines[:i+1]
+	if len(rest) > 0 {
+		item.title = strings.TrimSpace(rest[0])
+	}
+	if len(rest) > 2 {
+		item.body = strings.TrimRight(strings.Join(rest[2:], "\n"), "\n")
+	}
+	return item
+}
+
+// joplinFolderPath 沿著 parent_id 組合資料夾階層。
+func joplinFolderPath(items map[string]*joplinItem, parentID string) string {
+	var parts []string
+	seen := map[string]bool{}
+	for parentID != "" && !seen[parentID] {
+		seen[parentID] = true
+		folder, ok := items[parentID]
+		if !ok || folder.meta["type_"] != joplinFolder {
+			break
+		}
+		parts = append([]string{folder.title}, parts...)
+		parentID = folder.meta["parent_id"]
+	}
+	return strings.Join(parts, "/")
+}
+
+// parseJoplinTime 依序嘗試解析候選的 Joplin 時間欄位。
+func parseJoplinTime(candidates ...string) (time.Time, bool) {
+	for _, value := range candidates {
+		if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,59 @@
+// Package importer 提供了 Joplin 匯入的單元測試。
+package importer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	joplinFolderID   = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	joplinNoteAID    = "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+	joplinNoteBID    = "cccccccccccccccccccccccccccccccc"
+	joplinResourceID = "dddddddddddddddddddddddddddddddd"
+	joplinTagID      = "eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee"
+)
+
+// TestParseJoplinItem 測試 RAW 匯出檔的標題、內文與元資料解析。
+func TestParseJoplinItem(t *testing.T) {
+	item := parseJoplinItem("標題\n\n第一行\n\n第二行\n\nid: " + joplinNoteAID + "\nparent_id: \ntype_: 1\n")
+	assert.Equal(t, "標題", item.title)
+	assert.Equal(t, "第一行\n\n第二行", item.body)
+	assert.Equal(t, joplinNoteAID, item.meta["id"])
+	assert.Equal(t, "", item.meta["parent_id"])
+	assert.Equal(t, "1", item.meta["type_"])
+}
+
+// TestImport_Joplin 測試 Joplin 的資料夾、標籤、資源與筆記連結轉換。
+func TestImport_Joplin(t *testing.T) {
+	setupTestDataHome(t)
+	root := t.TempDir()
+	writeFiles(t, root, map[string]string{
+		joplinFolderID + ".md": "工作\n\nid: " + joplinFolderID + "\nparent_id: \ntype_: 2\n",
+		joplinNoteAID + ".md": "會議記錄\n\n請看 [規格](:/" + joplinNoteBID + ")\n![截圖](:/" + joplinResourceID + ")\n\n" +
+			"id: " + joplinNoteAID + "\nparent_id: " + joplinFolderID + "\ncreated_time: 2020-03-04T05:06:07.890Z\nuser_created_time: 2020-03-04T05:06:07.890Z\ntype_: 1\n",
+		joplinNoteBID + ".md":                    "規格\n\n內容\n\nid: " + joplinNoteBID + "\nparent_id: \ncreated_time: 2020-03-05T00:00:00.000Z\ntype_: 1\n",
+		joplinResourceID + ".md":                 "screenshot.png\n\nid: " + joplinResourceID + "\nmime: image/png\nfile_extension: png\ntype_: 4\n",
+		joplinTagID + ".md":                      "meeting\n\nid: " + joplinTagID + "\ntype_: 5\n",
+		"ffffffffffffffffffffffffffffffff.md":    "id: ffffffffffffffffffffffffffffffff\nnote_id: " + joplinNoteAID + "\ntag_id: " + joplinTagID + "\ntype_: 6\n",
+		"99999999999999999999999999999999.md":    "revision\n\nid: 99999999999999999999999999999999\ntype_: 13\n",
+		"resources/" + joplinResourceID + ".png": "PNG",
+	})
+
+	rep, err := Import(FormatJoplin, root)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"會議記錄", "規格"}, rep.Imported)
+	require.Len(t, rep.Problems, 1)
+	assert.Contains(t, rep.Problems[0], "不支援的項目類型 13")
+
+	notes := notesByTitle(t)
+	meeting := notes["會議記錄"]
+	require.NotNil(t, meeting)
+	assert.Equal(t, "工作", meeting.Folder)
+	assert.Equal(t, []string{"meeting"}, meeting.Tags)
+	assert.Equal(t, "20200304050607", meeting.ID())
+	assert.Equal(t, "joplin:"+joplinNoteAID, meeting.Source)
+	assert.Equal(t, "請看 [[規格]]\n![截圖](../../attachments/screenshot.png)", meeting.Content)
+}
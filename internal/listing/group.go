@@ -0,0 +1,120 @@
+// Package listing 依排序鍵與分組方式整理筆記列表，供 CLI 的 note list 與 TUI 的列表視圖共用。
+package listing
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/wtg42/ora-ora-ora/internal/note"
+)
+
+// 日期分組的區間名稱，依由新到舊的順序排列；顯示文字由表示層翻譯。
+const (
+	BucketToday     = "today"      // 今天。
+	BucketYesterday = "yesterday"  // 昨天。
+	BucketThisWeek  = "this_week"  // 本週（週一起算）更早的日子。
+	BucketThisMonth = "this_month" // 本月更早的日子。
+	BucketThisYear  = "this_year"  // 今年更早的日子。
+	BucketOlder     = "older"      // 今年以前。
+	BucketNever     = "never"      // 沒有日期，例如未曾查看的筆記。
+)
+
+// buckets 是日期區間由新到舊的順序。
+var buckets = []string{BucketToday, BucketYesterday, BucketThisWeek, BucketThisMonth, BucketThisYear, BucketOlder, BucketNever}
+
+// Group 是分組後的一組筆記。
+type Group struct {
+	// Name 依分組方式為日期區間（Bucket* 常數）、標籤或資料夾；
+	// 沒有標籤的筆記與資料目錄根部的筆記為空字串。
+	Name  string
+	Notes []*note.Note
+}
+
+// Bucket 返回時間 t 相對於 now 所在的日期區間，以 now 的時區計算；t 為零值時返回 BucketNever。
+func Bucket(t, now time.Time) string {
+	if t.IsZero() {
+		return BucketNever
+	}
+	t = t.In(now.Location())
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	// AI 心智註解: 週一為一週的開始；time.Weekday 以週日為 0，因此先平移一天。
+	weekStart := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+	switch {
+	case !t.Before(today):
+		// AI 心智註解: 時鐘偏差造成的未來時間也算今天，避免多出一個「未來」區間。
+		return BucketToday
+	case !t.Before(today.AddDate(0, 0, -1)):
+		return BucketYesterday
+	case !t.Before(weekStart):
+		return BucketThisWeek
+	case t.Year() == now.Year() && t.Month() == now.Month():
+		return BucketThisMonth
+	case t.Year() == now.Year():
+		return BucketThisYear
+	}
+	return BucketOlder
+}
+
+// GroupNotes 依 o 的分組方式將筆記分組，每組內保留 notes 原本的順序，因此應先以 Sort 排序。
+// 日期區間由新到舊排列，依日期遞增排序時改為由舊到新，未曾查看的區間固定在最後；
+// 標籤與資料夾依名稱排列，沒有標籤的組排在最後，資料目錄根部排在最前。
+// 不分組時所有筆記都在名稱為空字串的同一組。
+func GroupNotes(notes []*note.Note, o Options, views Views, now time.Time) []Group {
+	index := map[string]int{}
+	var groups []Group
+	add := func(name string, n *note.Note) {
+		i, ok := index[name]
+		if !ok {
+			i = len(groups)
+			index[name] = i
+			groups = append(groups, Group{Name: name})
+		}
+		groups[i].Notes = append(groups[i].Notes, n)
+	}
+	for _, n := range notes {
+		switch o.Group {
+		case GroupDate:
+			add(Bucket(Date(n, o.Key, views), now), n)
+		case GroupTag:
+			if len(n.Tags) == 0 {
+				add("", n)
+			}
+			for _, tag := range n.Tags {
+				add(tag, n)
+			}
+		case GroupFolder:
+			add(n.Folder, n)
+		default:
+			add("", n)
+		}
+	}
+
+	switch o.Group {
+	case GroupDate:
+		rank := func(name string) int {
+			i := slices.Index(buckets, name)
+			if name != BucketNever && isDateKey(o.Key) && !o.Desc {
+				return -i
+			}
+			return i
+		}
+		slices.SortStableFunc(groups, func(a, b Group) int { return rank(a.Name) - rank(b.Name) })
+	case GroupTag:
+		// AI 心智註解: 空字串代表沒有標籤，排在所有標籤之後。
+		slices.SortStableFunc(groups, func(a, b Group) int {
+			if (a.Name == "") != (b.Name == "") {
+				return cmp.Compare(b.Name, a.Name)
+			}
+			return cmp.Compare(a.Name, b.Name)
+		})
+	case GroupFolder:
+		slices.SortStableFunc(groups, func(a, b Group) int { return cmp.Compare(a.Name, b.Name) })
+	}
+	return groups
+}
+
+// isDateKey 判斷排序鍵是否依日期排序。
+func isDateKey(k Key) bool {
+	return k == KeyCreated || k == KeyUpdated || k == KeyViewed
+}
@@ -0,0 +1,89 @@
+// Package listing 提供了筆記列表分組的單元測試。
+package listing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBucket 測試日期區間以週一作為一週的開始。
+func TestBucket(t *testing.T) {
+	// 2026-10-21 是週三。
+	now := time.Date(2026, 10, 21, 15, 0, 0, 0, time.Local)
+	tests := map[string]time.Time{
+		BucketToday:     time.Date(2026, 10, 21, 0, 0, 0, 0, time.Local),
+		BucketYesterday: time.Date(2026, 10, 20, 23, 59, 0, 0, time.Local),
+		BucketThisWeek:  time.Date(2026, 10, 19, 8, 0, 0, 0, time.Local),
+		BucketThisMonth: time.Date(2026, 10, 18, 8, 0, 0, 0, time.Local),
+		BucketThisYear:  time.Date(2026, 2, 1, 8, 0, 0, 0, time.Local),
+		BucketOlder:     time.Date(2025, 12, 31, 8, 0, 0, 0, time.Local),
+		BucketNever:     {},
+	}
+	for want, at := range tests {
+		assert.Equal(t, want, Bucket(at, now), at.String())
+	}
+	assert.Equal(t, BucketToday, Bucket(now.Add(time.Hour), now), "未來的時間算作今天")
+}
+
+// groupSummary 返回每組的名稱與其中筆記的標題，方便比對。
+func groupSummary(groups []Group) map[string][]string {
+	out := map[string][]string{}
+	for _, g := range groups {
+		out[g.Name] = titles(g.Notes)
+	}
+	return out
+}
+
+// groupNames 返回各組的名稱，方便比對順序。
+func groupNames(groups []Group) []string {
+	out := make([]string, len(groups))
+	for i, g := range groups {
+		out[i] = g.Name
+	}
+	return out
+}
+
+// TestGroupNotes_Tag 測試有多個標籤的筆記出現在每個標籤下，沒有標籤的組排在最後。
+func TestGroupNotes_Tag(t *testing.T) {
+	groups := GroupNotes(sampleNotes(), Options{Key: KeyCreated, Group: GroupTag}, nil, time.Now())
+	assert.Equal(t, []string{"home", "work", ""}, groupNames(groups))
+	assert.Equal(t, map[string][]string{
+		"home": {"gamma"},
+		"work": {"beta", "gamma"},
+		"":     {"Alpha"},
+	}, groupSummary(groups))
+}
+
+func TestGroupNotes_Folder(t *testing.T) {
+	groups := GroupNotes(sampleNotes(), Options{Key: KeyTitle, Group: GroupFolder}, nil, time.Now())
+	assert.Equal(t, []string{"", "projects"}, groupNames(groups))
+	assert.Equal(t, []string{"beta", "gamma"}, titles(groups[0].Notes), "組內保留傳入的順序")
+}
+
+// TestGroupNotes_Date 測試日期區間的順序跟隨日期排序的方向，未曾查看的區間固定在最後。
+func TestGroupNotes_Date(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.Local)
+	notes := sampleNotes()
+
+	o := Options{Key: KeyUpdated, Desc: true, Group: GroupDate}
+	groups := GroupNotes(Sort(notes, o, nil), o, nil, now)
+	assert.Equal(t, []string{BucketYesterday, BucketThisMonth}, groupNames(groups))
+	assert.Equal(t, []string{"gamma", "beta"}, titles(groups[1].Notes))
+
+	o.Desc = false
+	assert.Equal(t, []string{BucketThisMonth, BucketYesterday}, groupNames(GroupNotes(Sort(notes, o, nil), o, nil, now)))
+
+	o = Options{Key: KeyViewed, Group: GroupDate}
+	views := Views{notes[0].ID(): now}
+	assert.Equal(t, []string{BucketToday, BucketNever}, groupNames(GroupNotes(Sort(notes, o, views), o, views, now)))
+}
+
+func TestGroupNotes_None(t *testing.T) {
+	groups := GroupNotes(sampleNotes(), Default(), nil, time.Now())
+	require.Len(t, groups, 1)
+	assert.Empty(t, groups[0].Name)
+	assert.Len(t, groups[0].Notes, 3)
+}
@@ -0,0 +1,139 @@
+// Package listing 依排序鍵與分組方式整理筆記列表，供 CLI 的 note list 與 TUI 的列表視圖共用。
+package listing
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/wtg42/ora-ora-ora/internal/note"
+)
+
+// Key 是筆記列表的排序鍵。
+type Key string
+
+const (
+	KeyCreated Key = "created" // 建立時間。
+	KeyUpdated Key = "updated" // 更新時間，未編輯過的筆記以建立時間計算。
+	KeyTitle   Key = "title"   // 標題，不分大小寫。
+	KeySize    Key = "size"    // 內容的位元組數。
+	KeyViewed  Key = "viewed"  // 最近一次在 TUI 中查看的時間。
+)
+
+// Keys 列出所有排序鍵，TUI 依此順序切換。
+var Keys = []Key{KeyCreated, KeyUpdated, KeyTitle, KeySize, KeyViewed}
+
+// DefaultDesc 返回排序鍵未指定方向時的預設方向：標題由 A 到 Z，其餘由新到舊或由大到小。
+func (k Key) DefaultDesc() bool {
+	return k != KeyTitle
+}
+
+// Grouping 是筆記列表的分組方式。
+type Grouping string
+
+const (
+	GroupNone   Grouping = "none"   // 不分組。
+	GroupDate   Grouping = "date"   // 依排序鍵的日期分為今天、昨天、本週等區間。
+	GroupTag    Grouping = "tag"    // 依標籤分組，有多個標籤的筆記出現在每個標籤下。
+	GroupFolder Grouping = "folder" // 依所在資料夾分組。
+)
+
+// Groupings 列出所有分組方式，TUI 依此順序切換。
+var Groupings = []Grouping{GroupNone, GroupDate, GroupTag, GroupFolder}
+
+// Options 描述筆記列表的排序與分組。
+type Options struct {
+	Key   Key
+	Desc  bool     // 是否反向排序。
+	Group Grouping // 分組方式，空字串等同 GroupNone。
+}
+
+// Default 返回預設的排序：依建立時間由舊到新，不分組，與筆記檔名的順序一致。
+func Default() Options {
+	return Options{Key: KeyCreated, Group: GroupNone}
+}
+
+// ParseSort 解析排序設定，格式為 key 或 key:asc、key:desc，例如 updated:desc。
+// 未指定方向時使用 Key.DefaultDesc；無法解析時 ok 為 false。
+func ParseSort(s string) (key Key, desc bool, ok bool) {
+	name, dir, hasDir := strings.Cut(strings.ToLower(strings.TrimSpace(s)), ":")
+	key = Key(name)
+	if !slices.Contains(Keys, key) {
+		return "", false, false
+	}
+	if !hasDir {
+		return key, key.DefaultDesc(), true
+	}
+	switch dir {
+	case "asc":
+		return key, false, true
+	case "desc":
+		return key, true, true
+	}
+	return "", false, false
+}
+
+// ParseGroup 解析分組方式，空字串視為 GroupNone；無法解析時 ok 為 false。
+func ParseGroup(s string) (Grouping, bool) {
+	g := Grouping(strings.ToLower(strings.TrimSpace(s)))
+	if g == "" {
+		return GroupNone, true
+	}
+	return g, slices.Contains(Groupings, g)
+}
+
+// SortSpec 返回可由 ParseSort 解析的排序設定，例如 updated:desc。
+func (o Options) SortSpec() string {
+	if o.Desc {
+		return string(o.Key) + ":desc"
+	}
+	return string(o.Key) + ":asc"
+}
+
+// Date 返回筆記在排序鍵下代表的日期，用於日期分組與列表的日期欄。
+// 依更新時間時以建立時間補上未編輯過的筆記；依查看時間時未曾查看的筆記返回零值；其餘使用建立時間。
+func Date(n *note.Note, key Key, views Views) time.Time {
+	switch key {
+	case KeyUpdated:
+		if n.UpdatedAt.IsZero() {
+			return n.CreatedAt
+		}
+		return n.UpdatedAt
+	case KeyViewed:
+		return views[n.ID()]
+	}
+	return n.CreatedAt
+}
+
+// Sort 返回依 o 的排序鍵與方向排序後的筆記副本，相同排序值的筆記保留原本的順序。
+// 依查看時間排序時，未曾查看的筆記不論方向都排在最後。
+func Sort(notes []*note.Note, o Options, views Views) []*note.Note {
+	sorted := slices.Clone(notes)
+	compare := func(a, b *note.Note) int {
+		switch o.Key {
+		case KeyTitle:
+			return cmp.Compare(strings.ToLower(a.Title), strings.ToLower(b.Title))
+		case KeySize:
+			return cmp.Compare(len(a.Content), len(b.Content))
+		}
+		return Date(a, o.Key, views).Compare(Date(b, o.Key, views))
+	}
+	slices.SortStableFunc(sorted, func(a, b *note.Note) int {
+		if o.Key == KeyViewed {
+			// AI 心智註解: 未曾查看的筆記沒有可比較的時間，固定放在最後，避免遞增排序時佔滿列表開頭。
+			aNever, bNever := views[a.ID()].IsZero(), views[b.ID()].IsZero()
+			if aNever != bNever {
+				if aNever {
+					return 1
+				}
+				return -1
+			}
+		}
+		if o.Desc {
+			return compare(b, a)
+		}
+		return compare(a, b)
+	})
+	return sorted
+}
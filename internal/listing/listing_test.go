@@ -0,0 +1,105 @@
+// Package listing 提供了筆記列表排序的單元測試。
+package listing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+)
+
+// sampleNotes 返回建立時間、更新時間、標題與內容長度各不相同的測試筆記。
+func sampleNotes() []*note.Note {
+	return []*note.Note{
+		{Title: "beta", Content: "12345", CreatedAt: time.Date(2026, 10, 1, 9, 0, 0, 0, time.Local), Tags: []string{"work"}},
+		{Title: "Alpha", Content: "1", CreatedAt: time.Date(2026, 10, 2, 9, 0, 0, 0, time.Local), UpdatedAt: time.Date(2026, 10, 18, 9, 0, 0, 0, time.Local), Folder: "projects"},
+		{Title: "gamma", Content: "123", CreatedAt: time.Date(2026, 10, 3, 9, 0, 0, 0, time.Local), Tags: []string{"work", "home"}},
+	}
+}
+
+// titles 返回筆記的標題列表，方便比對順序。
+func titles(notes []*note.Note) []string {
+	out := make([]string, len(notes))
+	for i, n := range notes {
+		out[i] = n.Title
+	}
+	return out
+}
+
+func TestParseSort(t *testing.T) {
+	tests := []struct {
+		in   string
+		key  Key
+		desc bool
+	}{
+		{"updated", KeyUpdated, true},
+		{"title", KeyTitle, false},
+		{"Size:ASC", KeySize, false},
+		{"title:desc", KeyTitle, true},
+		{" viewed ", KeyViewed, true},
+	}
+	for _, tt := range tests {
+		key, desc, ok := ParseSort(tt.in)
+		assert.True(t, ok, tt.in)
+		assert.Equal(t, tt.key, key, tt.in)
+		assert.Equal(t, tt.desc, desc, tt.in)
+	}
+	for _, in := range []string{"", "name", "title:up"} {
+		_, _, ok := ParseSort(in)
+		assert.False(t, ok, in)
+	}
+}
+
+func TestParseGroup(t *testing.T) {
+	g, ok := ParseGroup("")
+	assert.True(t, ok)
+	assert.Equal(t, GroupNone, g)
+	g, ok = ParseGroup("Tag")
+	assert.True(t, ok)
+	assert.Equal(t, GroupTag, g)
+	_, ok = ParseGroup("month")
+	assert.False(t, ok)
+}
+
+func TestSortSpec(t *testing.T) {
+	assert.Equal(t, "created:asc", Default().SortSpec())
+	assert.Equal(t, "updated:desc", Options{Key: KeyUpdated, Desc: true}.SortSpec())
+}
+
+// TestSort 測試各排序鍵與方向，且不修改傳入的切片。
+func TestSort(t *testing.T) {
+	notes := sampleNotes()
+	tests := []struct {
+		opts Options
+		want []string
+	}{
+		{Options{Key: KeyCreated}, []string{"beta", "Alpha", "gamma"}},
+		{Options{Key: KeyCreated, Desc: true}, []string{"gamma", "Alpha", "beta"}},
+		{Options{Key: KeyUpdated, Desc: true}, []string{"Alpha", "gamma", "beta"}},
+		{Options{Key: KeyTitle}, []string{"Alpha", "beta", "gamma"}},
+		{Options{Key: KeySize, Desc: true}, []string{"beta", "gamma", "Alpha"}},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, titles(Sort(notes, tt.opts, nil)), tt.opts.SortSpec())
+	}
+	assert.Equal(t, []string{"beta", "Alpha", "gamma"}, titles(notes), "原本的切片不應被排序")
+}
+
+// TestSort_Viewed 測試依查看時間排序時，未曾查看的筆記不論方向都排在最後。
+func TestSort_Viewed(t *testing.T) {
+	notes := sampleNotes()
+	views := Views{
+		notes[0].ID(): time.Date(2026, 10, 10, 9, 0, 0, 0, time.Local),
+		notes[2].ID(): time.Date(2026, 10, 12, 9, 0, 0, 0, time.Local),
+	}
+	assert.Equal(t, []string{"gamma", "beta", "Alpha"}, titles(Sort(notes, Options{Key: KeyViewed, Desc: true}, views)))
+	assert.Equal(t, []string{"beta", "gamma", "Alpha"}, titles(Sort(notes, Options{Key: KeyViewed}, views)))
+}
+
+func TestDate(t *testing.T) {
+	n := sampleNotes()[0]
+	assert.Equal(t, n.CreatedAt, Date(n, KeyUpdated, nil), "未編輯過的筆記以建立時間作為更新時間")
+	assert.True(t, Date(n, KeyViewed, nil).IsZero())
+	assert.Equal(t, n.CreatedAt, Date(n, KeyTitle, nil))
+}
@@ -0,0 +1,66 @@
+// Package listing 依排序鍵與分組方式整理筆記列表，供 CLI 的 note list 與 TUI 的列表視圖共用。
+package listing
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+)
+
+// AI 心智註解: 與 storage 相同，錯誤只使用不含語系的英文，由表示層加上翻譯後的說明。
+
+// Views 記錄每則筆記最近一次在 TUI 中查看的時間，鍵為筆記 ID。
+type Views map[string]time.Time
+
+// DefaultViewsPath 返回查看紀錄檔的預設路徑（資料目錄下的 state/views.json）。
+func DefaultViewsPath() (string, error) {
+	dir, err := storage.GetAppDataSubDir("state")
+	if err != nil {
+		return "", fmt.Errorf("get state dir: %w", err)
+	}
+	return filepath.Join(dir, "views.json"), nil
+}
+
+// LoadViews 讀取查看紀錄檔，檔案不存在時返回空的紀錄。
+func LoadViews(path string) (Views, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return Views{}, nil
+	}
+	if err != nil {
+		return Views{}, fmt.Errorf("read %s: %w", path, err)
+	}
+	views := Views{}
+	if err := json.Unmarshal(data, &views); err != nil {
+		return Views{}, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return views, nil
+}
+
+// RecordView 將筆記 id 的查看時間 at 寫入查看紀錄檔。
+// 先重新讀取檔案再寫入，避免覆蓋同時執行的其他 TUI 記錄的時間；寫入暫存檔再改名以避免寫到一半的檔案。
+func RecordView(path, id string, at time.Time) error {
+	views, err := LoadViews(path)
+	if err != nil {
+		return err
+	}
+	views[id] = at
+	data, err := json.MarshalIndent(views, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode views: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	return nil
+}
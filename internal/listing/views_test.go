@@ -0,0 +1,40 @@
+// Package listing 提供了查看紀錄的單元測試。
+package listing
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRecordView 測試查看時間的寫入與讀回，且不覆蓋其他筆記的紀錄。
+func TestRecordView(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "views.json")
+
+	views, err := LoadViews(path)
+	require.NoError(t, err)
+	assert.Empty(t, views, "檔案不存在時返回空的紀錄")
+
+	first := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	second := first.Add(time.Hour)
+	require.NoError(t, RecordView(path, "20261001090000", first))
+	require.NoError(t, RecordView(path, "20261002090000", second))
+
+	views, err = LoadViews(path)
+	require.NoError(t, err)
+	assert.True(t, views["20261001090000"].Equal(first))
+	assert.True(t, views["20261002090000"].Equal(second))
+}
+
+func TestLoadViews_Invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "views.json")
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0600))
+	views, err := LoadViews(path)
+	assert.Error(t, err)
+	assert.Empty(t, views)
+	assert.Error(t, RecordView(path, "x", time.Now()), "紀錄損毀時不覆寫")
+}
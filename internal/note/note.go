@@ -2,15 +2,27 @@
 package note
 
 import (
+	"regexp"
 	"time"
 )
 
+// IDLayout 是筆記 ID 的時間格式，同時作為檔案名稱的時間戳記前綴。
+const IDLayout = "20060102150405"
+
 // Note 結構體代表應用程式中的單一筆記條目。
 type Note struct {
-	Title     string    `json:"title"`          // 筆記的標題。
-	Content   string    `json:"content"`        // 筆記的內容。
-	Tags      []string  `json:"tags,omitempty"` // 筆記的標籤，可選。
-	CreatedAt time.Time `json:"created_at"`     // 筆記的建立時間。
+	Title     string    `json:"title"`               // 筆記的標題。
+	Content   string    `json:"content"`             // 筆記的內容。
+	Tags      []string  `json:"tags,omitempty"`      // 筆記的標籤，可選。
+	CreatedAt time.Time `json:"created_at"`          // 筆記的建立時間。
+	UpdatedAt time.Time `json:"updated_at,omitzero"` // 筆記的最後更新時間，未編輯過則為零值。
+	RemindAt  time.Time `json:"remind_at,omitzero"`  // 提醒時間，未設定則為零值。
+	Due       time.Time `json:"due,omitzero"`        // 到期時間，未設定則為零值。
+	Source    string    `json:"source,omitempty"`    // 匯入來源識別碼，例如 obsidian:folder/note.md，用於避免重複匯入。
+	Folder    string    `json:"folder,omitempty"`    // 筆記在資料目錄中的子資料夾，以 / 分隔，由檔案位置決定。
+	Pinned    bool      `json:"pinned,omitempty"`    // 是否釘選，釘選的筆記在 TUI 列表中固定顯示在最上方。
+	Path      string    `json:"-"`                   // 筆記檔案的路徑，由 storage 載入時填入，不序列化。
+	Extra     string    `json:"-"`                   // front matter 中 Ora 不使用的欄位（例如 aliases）的 YAML 原文，由 storage 讀寫時原樣保留。
 }
 
 // NewNote 函數建立一個新的 Note 實例。
@@ -23,3 +35,36 @@ func NewNote(title, content string, tags []string) *Note {
 		CreatedAt: time.Now(),
 	}
 }
+
+// ID 返回筆記的識別碼，即以 IDLayout 格式化的建立時間。
+func (n *Note) ID() string {
+	return n.CreatedAt.Format(IDLayout)
+}
+
+// linkPattern 匹配筆記內容中的內部連結，例如 [[筆記標題]]。
+var linkPattern = regexp.MustCompile(`\[\[([^\[\]]+)\]\]`)
+
+// Link 返回指向指定標題筆記的內部連結。
+func Link(title string) string {
+	return "[[" + title + "]]"
+}
+
+// Links 返回內容中所有內部連結的目標標題，依出現順序且不重複。
+func Links(content string) []string {
+	var titles []string
+	seen := map[string]bool{}
+	for _, m := range linkPattern.FindAllStringSubmatch(content, -1) {
+		if !seen[m[1]] {
+			seen[m[1]] = true
+			titles = append(titles, m[1])
+		}
+	}
+	return titles
+}
+
+// ReplaceLinks 以 replace 的返回值取代內容中的每個內部連結，replace 接收連結的目標標題。
+func ReplaceLinks(content string, replace func(title string) string) string {
+	return linkPattern.ReplaceAllStringFunc(content, func(match string) string {
+		return replace(linkPattern.FindStringSubmatch(match)[1])
+	})
+}
@@ -39,3 +39,32 @@ func TestNewNote(t *testing.T) {
 		t.Error("CreatedAt 時間戳記不夠新")
 	}
 }
+
+// TestNoteID 測試 ID 方法是否以建立時間產生識別碼。
+func TestNoteID(t *testing.T) {
+	n := &Note{CreatedAt: time.Date(2025, 10, 3, 12, 30, 45, 0, time.UTC)}
+
+	// 檢查 ID 是否與檔名時間戳記格式一致。
+	if got := n.ID(); got != "20251003123045" {
+		t.Errorf("預期 ID 為 %q, 實際得到 %q", "20251003123045", got)
+	}
+}
+
+// TestLinks 測試內部連結的產生與解析。
+func TestLinks(t *testing.T) {
+	content := "參考 " + Link("Go 筆記") + " 與 [[會議]]，再看一次 [[Go 筆記]]。[不是連結]"
+
+	got := Links(content)
+	expected := []string{"Go 筆記", "會議"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("預期連結為 %v, 實際得到 %v", expected, got)
+	}
+}
+
+// TestReplaceLinks 測試內部連結的替換。
+func TestReplaceLinks(t *testing.T) {
+	got := ReplaceLinks("見 [[甲]] 與 [[乙]]", func(title string) string { return "<" + title + ">" })
+	if got != "見 <甲> 與 <乙>" {
+		t.Errorf("預期 %q, 實際得到 %q", "見 <甲> 與 <乙>", got)
+	}
+}
@@ -0,0 +1,101 @@
+// Package remind 負責從筆記與待辦項目中收集提醒，並由常駐程序在到期時發送通知。
+package remind
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/wtg42/ora-ora-ora/internal/note"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+)
+
+// DefaultInterval 是常駐程序檢查提醒的預設間隔。
+const DefaultInterval = time.Minute
+
+// Daemon 定期載入筆記，送出到期的提醒並持久化送出狀態。
+type Daemon struct {
+	Clock     Clock                        // 時間來源。
+	Interval  time.Duration                // 檢查間隔。
+	StatePath string                       // 提醒狀態檔路徑。
+	Notifiers []Notifier                   // 提醒送出方式。
+	LoadNotes func() ([]*note.Note, error) // 載入筆記的函數，預設為 storage.LoadAllNotes。
+	Format    func(r Reminder) string      // 產生通知顯示的文字，寫入提醒的 Title；為 nil 時保留 Collect 填入的 Title。
+}
+
+// NewDaemon 建立使用系統時鐘與預設設定的 Daemon。
+func NewDaemon(statePath string, notifiers ...Notifier) *Daemon {
+	return &Daemon{
+		Clock:     RealClock(),
+		Interval:  DefaultInterval,
+		StatePath: statePath,
+		Notifiers: notifiers,
+		LoadNotes: storage.LoadAllNotes,
+	}
+}
+
+// Tick 執行一次檢查：送出所有到期且未送出的提醒，返回已送出的提醒。
+// 只要有一種通知方式成功，提醒就會被標記為已送出，其他通知方式的錯誤一併返回；
+// 所有通知方式都失敗時，該提醒不會被標記，下次檢查會重試。
+// 部分筆記無法讀取時仍處理其餘筆記的提醒，並連同其他錯誤一併返回。
+func (d *Daemon) Tick() ([]Reminder, error) {
+	notes, loadErr := d.LoadNotes()
+	var skipped *storage.SkippedNotesError
+	if loadErr != nil && !errors.As(loadErr, &skipped) {
+		return nil, loadErr
+	}
+	state, err := LoadState(d.StatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var fired []Reminder
+	errs := []error{loadErr}
+	for _, r := range state.Due(Collect(notes), d.Clock.Now()) {
+		if d.Format != nil {
+			r.Title = d.Format(r)
+		}
+		delivered, err := d.notify(r)
+		errs = append(errs, err)
+		if !delivered {
+			continue
+		}
+		state.MarkDelivered(r)
+		fired = append(fired, r)
+	}
+
+	if err := SaveState(d.StatePath, state); err != nil {
+		return fired, err
+	}
+	return fired, errors.Join(errs...)
+}
+
+// notify 依序呼叫所有通知方式，返回是否至少一種成功，以及失敗者的錯誤。
+// AI 心智註解: 不在第一個錯誤就停止，否則已成功的通知方式會因提醒未標記而在每次檢查時重複觸發。
+func (d *Daemon) notify(r Reminder) (bool, error) {
+	delivered := false
+	var errs []error
+	for _, n := range d.Notifiers {
+		if err := n.Notify(r); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		delivered = true
+	}
+	return delivered, errors.Join(errs...)
+}
+
+// Run 立即檢查一次，之後每隔 Interval 檢查，直到 ctx 被取消。
+// 單次檢查的錯誤會交給 onError 處理而不中斷常駐程序。
+func (d *Daemon) Run(ctx context.Context, onError func(error)) error {
+	for {
+		if _, err := d.Tick(); err != nil && onError != nil {
+			onError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-d.Clock.After(d.Interval):
+		}
+	}
+}
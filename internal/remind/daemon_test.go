@@ -0,0 +1,142 @@
+// Package remind 提供了提醒常駐程序的單元測試。
+package remind
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+)
+
+// failingNotifier 是永遠失敗的 Notifier，用於測試重試行為。
+type failingNotifier struct{}
+
+// Notify 總是返回錯誤。
+func (failingNotifier) Notify(Reminder) error { return errors.New("notify failed") }
+
+// newTestDaemon 建立使用假時鐘與固定筆記的 Daemon。
+func newTestDaemon(t *testing.T, clock Clock, notifiers ...Notifier) *Daemon {
+	d := NewDaemon(filepath.Join(t.TempDir(), "reminders.json"), notifiers...)
+	d.Clock = clock
+	d.LoadNotes = func() ([]*note.Note, error) { return reminderNotes(), nil }
+	return d
+}
+
+// TestDaemonTick 測試每個提醒只送出一次，且狀態會持久化。
+func TestDaemonTick(t *testing.T) {
+	var out bytes.Buffer
+	clock := newFakeClock(time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC))
+	d := newTestDaemon(t, clock, WriterNotifier{W: &out})
+
+	fired, err := d.Tick()
+	require.NoError(t, err)
+	assert.Empty(t, fired)
+
+	clock.now = time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	fired, err = d.Tick()
+	require.NoError(t, err)
+	require.Len(t, fired, 1)
+	assert.Contains(t, out.String(), "20261001080000 牙醫")
+
+	// 同一個提醒不應再次送出，即使重新建立 Daemon。
+	d2 := newTestDaemon(t, clock, WriterNotifier{W: &out})
+	d2.StatePath = d.StatePath
+	fired, err = d2.Tick()
+	require.NoError(t, err)
+	assert.Empty(t, fired)
+}
+
+// TestDaemonTick_NotifyFailure 測試通知失敗時不標記為已送出。
+func TestDaemonTick_NotifyFailure(t *testing.T) {
+	clock := newFakeClock(time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC))
+	d := newTestDaemon(t, clock, failingNotifier{})
+
+	fired, err := d.Tick()
+	assert.Error(t, err)
+	assert.Empty(t, fired)
+
+	s, err := LoadState(d.StatePath)
+	require.NoError(t, err)
+	assert.Empty(t, s.Delivered)
+}
+
+// TestDaemonTick_PartialNotifyFailure 測試至少一種通知方式成功時標記為已送出，不再重複觸發，失敗者的錯誤仍會返回。
+func TestDaemonTick_PartialNotifyFailure(t *testing.T) {
+	var out bytes.Buffer
+	clock := newFakeClock(time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC))
+	d := newTestDaemon(t, clock, WriterNotifier{W: &out}, failingNotifier{})
+
+	fired, err := d.Tick()
+	assert.EqualError(t, err, "notify failed")
+	require.Len(t, fired, 1)
+
+	fired, err = d.Tick()
+	require.NoError(t, err)
+	assert.Empty(t, fired)
+	assert.Equal(t, 1, strings.Count(out.String(), "牙醫"))
+}
+
+// TestDaemonTick_SkippedNotes 測試部分筆記無法讀取時仍送出其餘筆記的提醒並回報錯誤。
+func TestDaemonTick_SkippedNotes(t *testing.T) {
+	var out bytes.Buffer
+	clock := newFakeClock(time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC))
+	d := newTestDaemon(t, clock, WriterNotifier{W: &out})
+	skipped := &storage.SkippedNotesError{Errs: []error{errors.New("壞筆記")}}
+	d.LoadNotes = func() ([]*note.Note, error) { return reminderNotes(), skipped }
+
+	fired, err := d.Tick()
+	assert.ErrorIs(t, err, skipped)
+	assert.Len(t, fired, 1)
+}
+
+// TestDaemonRun 測試常駐程序會隨假時鐘推進送出提醒並在取消後結束。
+func TestDaemonRun(t *testing.T) {
+	var out bytes.Buffer
+	clock := newFakeClock(time.Date(2026, 10, 19, 8, 30, 0, 0, time.UTC))
+	d := newTestDaemon(t, clock, WriterNotifier{W: &out})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- d.Run(ctx, nil) }()
+
+	clock.Advance(time.Hour)
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+	assert.Equal(t, 1, strings.Count(out.String(), "牙醫"))
+}
+
+// TestLogFileNotifier 測試提醒會附加寫入日誌檔。
+func TestLogFileNotifier(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "remind.log")
+	n := LogFileNotifier{Path: path}
+	r := Reminder{ID: "1", Title: "第一則", At: time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)}
+
+	require.NoError(t, n.Notify(r))
+	require.NoError(t, n.Notify(r))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, 2, strings.Count(string(data), "[2026-10-19T09:00:00Z] 1 第一則"))
+}
+
+// TestHookNotifier 測試 hook 指令能取得提醒的環境變數。
+func TestHookNotifier(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "hook.out")
+	n := HookNotifier{Command: `printf '%s|%s' "$ORA_REMINDER_ID" "$ORA_REMINDER_TITLE" > "` + path + `"`}
+
+	require.NoError(t, n.Notify(Reminder{ID: "abc", Title: "hook 測試", At: time.Now()}))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "abc|hook 測試", string(data))
+
+	assert.Error(t, HookNotifier{Command: "exit 3"}.Notify(Reminder{}))
+}
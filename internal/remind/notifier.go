@@ -0,0 +1,69 @@
+// Package remind 負責從筆記與待辦項目中收集提醒，並由常駐程序在到期時發送通知。
+package remind
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"time"
+)
+
+// Notifier 定義送出提醒通知的方式。
+type Notifier interface {
+	Notify(r Reminder) error
+}
+
+// formatLine 將提醒格式化為單行文字。
+func formatLine(r Reminder) string {
+	return fmt.Sprintf("[%s] %s %s", r.At.Format(time.RFC3339), r.ID, r.Title)
+}
+
+// WriterNotifier 將提醒以單行文字寫入 io.Writer，例如 stdout。
+type WriterNotifier struct {
+	W io.Writer
+}
+
+// Notify 寫出一行提醒文字。
+func (n WriterNotifier) Notify(r Reminder) error {
+	_, err := fmt.Fprintln(n.W, formatLine(r))
+	return err
+}
+
+// LogFileNotifier 將提醒附加寫入指定的日誌檔。
+type LogFileNotifier struct {
+	Path string
+}
+
+// Notify 以附加模式寫入一行提醒文字。
+func (n LogFileNotifier) Notify(r Reminder) error {
+	f, err := os.OpenFile(n.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return &PathError{Op: OpOpenLog, Path: n.Path, Err: err}
+	}
+	defer f.Close()
+	if _, err := fmt.Fprintln(f, formatLine(r)); err != nil {
+		return &PathError{Op: OpWriteLog, Path: n.Path, Err: err}
+	}
+	return nil
+}
+
+// HookNotifier 透過 shell 執行使用者指定的指令送出通知。
+// 提醒內容以環境變數 ORA_REMINDER_ID、ORA_REMINDER_TITLE 與 ORA_REMINDER_AT 傳入。
+type HookNotifier struct {
+	Command string
+}
+
+// Notify 執行 hook 指令並等待其結束。
+func (n HookNotifier) Notify(r Reminder) error {
+	cmd := exec.Command("sh", "-c", n.Command)
+	cmd.Env = append(os.Environ(),
+		"ORA_REMINDER_ID="+r.ID,
+		"ORA_REMINDER_TITLE="+r.Title,
+		"ORA_REMINDER_AT="+r.At.Format(time.RFC3339),
+	)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return &HookError{Err: err, Output: string(out)}
+	}
+	return nil
+}
@@ -0,0 +1,282 @@
+// Package remind 負責從筆記與待辦項目中收集提醒，並由常駐程序在到期時發送通知。
+package remind
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/wtg42/ora-ora-ora/internal/note"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+	"github.com/wtg42/ora-ora-ora/internal/task"
+)
+
+// Clock 抽象化時間來源，讓排程邏輯可以在測試中使用假時鐘。
+type Clock interface {
+	Now() time.Time                         // 返回當前時間。
+	After(d time.Duration) <-chan time.Time // 在經過 d 之後送出時間。
+}
+
+// realClock 是使用系統時間的 Clock 實作。
+type realClock struct{}
+
+// Now 返回系統當前時間。
+func (realClock) Now() time.Time { return time.Now() }
+
+// After 包裝 time.After。
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// RealClock 返回使用系統時間的 Clock。
+func RealClock() Clock {
+	return realClock{}
+}
+
+// Kind 區分提醒的來源。
+type Kind string
+
+const (
+	KindRemind Kind = "remind" // 筆記的 remind_at 欄位。
+	KindDue    Kind = "due"    // 筆記的 due 欄位。
+	KindTask   Kind = "task"   // 未完成待辦項目的 due: 標記。
+)
+
+// Reminder 結構體代表一個排定時間的提醒。
+type Reminder struct {
+	ID    string    `json:"id"`             // 提醒識別碼：筆記為 <ID> 或 <ID>:due，待辦項目為 <ID>:t<雜湊>。
+	Kind  Kind      `json:"kind"`           // 提醒的來源。
+	Title string    `json:"title"`          // 通知顯示的文字；Collect 填入筆記標題或待辦項目文字，Daemon 的 Format 可改寫。
+	Note  string    `json:"note,omitempty"` // 待辦項目所屬筆記的標題。
+	At    time.Time `json:"at"`             // 排定的提醒時間。
+}
+
+// NotFoundError 表示找不到識別碼為 ID 的提醒。
+type NotFoundError struct {
+	ID string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("reminder %q not found", e.ID)
+}
+
+// ErrInvalidSnooze 表示延後時間不大於零。
+var ErrInvalidSnooze = errors.New("snooze duration must be positive")
+
+// Op 表示提醒狀態檔或日誌檔的操作，用於 PathError。
+type Op string
+
+const (
+	OpStateDir   Op = "get state dir"        // 取得提醒狀態目錄。
+	OpReadState  Op = "read reminder state"  // 讀取提醒狀態檔。
+	OpParseState Op = "parse reminder state" // 解析提醒狀態檔。
+	OpWriteState Op = "write reminder state" // 寫入提醒狀態檔。
+	OpOpenLog    Op = "open reminder log"    // 開啟提醒日誌檔。
+	OpWriteLog   Op = "write reminder log"   // 寫入提醒日誌檔。
+)
+
+// PathError 記錄提醒狀態檔或日誌檔操作失敗的操作、路徑與底層錯誤。
+type PathError struct {
+	Op   Op
+	Path string
+	Err  error
+}
+
+func (e *PathError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("%s: %v", e.Op, e.Err)
+	}
+	return fmt.Sprintf("%s %s: %v", e.Op, e.Path, e.Err)
+}
+
+func (e *PathError) Unwrap() error {
+	return e.Err
+}
+
+// HookError 表示提醒 hook 指令執行失敗，Output 是指令的輸出。
+type HookError struct {
+	Err    error
+	Output string
+}
+
+func (e *HookError) Error() string {
+	return fmt.Sprintf("run reminder hook: %v: %s", e.Err, e.Output)
+}
+
+func (e *HookError) Unwrap() error {
+	return e.Err
+}
+
+// Collect 從筆記的 remind_at/due 欄位與未完成待辦項目的 due: 標記收集提醒，依時間排序。
+// 待辦項目的到期日在當天零時提醒。
+func Collect(notes []*note.Note) []Reminder {
+	var reminders []Reminder
+	for _, n := range notes {
+		if !n.RemindAt.IsZero() {
+			reminders = append(reminders, Reminder{ID: n.ID(), Kind: KindRemind, Title: n.Title, At: n.RemindAt})
+		}
+		if !n.Due.IsZero() {
+			reminders = append(reminders, Reminder{ID: n.ID() + ":due", Kind: KindDue, Title: n.Title, At: n.Due})
+		}
+		for _, t := range task.Parse(n) {
+			if t.Done || t.Due.IsZero() {
+				continue
+			}
+			reminders = append(reminders, Reminder{ID: taskReminderID(t), Kind: KindTask, Title: t.Text, Note: t.NoteTitle, At: t.Due})
+		}
+	}
+	sort.SliceStable(reminders, func(i, j int) bool { return reminders[i].At.Before(reminders[j].At) })
+	return reminders
+}
+
+// taskReminderID 返回待辦項目提醒的識別碼，由筆記 ID 與項目文字、到期日的雜湊組成。
+// AI 心智註解: 不使用 <ID>:<行號>，否則在項目上方插入一行就會讓已送出的提醒換了 ID 而重複送出，
+// 或讓另一個項目繼承已送出的狀態而漏送。
+func taskReminderID(t task.Task) string {
+	sum := sha256.Sum256([]byte(t.Text + "\x00" + t.Due.Format(time.RFC3339)))
+	return fmt.Sprintf("%s:t%x", t.NoteID, sum[:4])
+}
+
+// State 記錄已送出與延後的提醒，持久化於提醒狀態檔中。
+type State struct {
+	Delivered map[string]time.Time `json:"delivered"` // 提醒 ID → 已送出的排定時間。
+	Snoozed   map[string]time.Time `json:"snoozed"`   // 提醒 ID → 延後至的時間。
+}
+
+// NewState 返回空的提醒狀態。
+func NewState() *State {
+	return &State{
+		Delivered: map[string]time.Time{},
+		Snoozed:   map[string]time.Time{},
+	}
+}
+
+// effectiveTime 返回考慮延後後的提醒時間。
+func (s *State) effectiveTime(r Reminder) time.Time {
+	if until, ok := s.Snoozed[r.ID]; ok && until.After(r.At) {
+		return until
+	}
+	return r.At
+}
+
+// Due 返回在 now 之前到期且尚未送出的提醒。
+// 提醒時間或延後時間改到上次送出之後，提醒會再次被視為未送出。
+func (s *State) Due(reminders []Reminder, now time.Time) []Reminder {
+	var due []Reminder
+	for _, r := range reminders {
+		at := s.effectiveTime(r)
+		if at.After(now) {
+			continue
+		}
+		// AI 心智註解: 以「送出時間不早於排定時間」判斷，延後的紀錄在送出後被清除時，原本的提醒時間也不會再次觸發。
+		if delivered, ok := s.Delivered[r.ID]; ok && !delivered.Before(at) {
+			continue
+		}
+		due = append(due, r)
+	}
+	return due
+}
+
+// MarkDelivered 記錄提醒已依目前的排定時間送出。
+func (s *State) MarkDelivered(r Reminder) {
+	s.Delivered[r.ID] = s.effectiveTime(r)
+}
+
+// Snooze 將提醒延後至 until。
+func (s *State) Snooze(id string, until time.Time) {
+	s.Snoozed[id] = until
+}
+
+// DefaultStatePath 返回提醒狀態檔的預設路徑。
+func DefaultStatePath() (string, error) {
+	dir, err := storageStateDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "reminders.json"), nil
+}
+
+// storageStateDir 返回提醒狀態檔所在的目錄。
+func storageStateDir() (string, error) {
+	dir, err := storage.GetAppDataSubDir("state")
+	if err != nil {
+		return "", &PathError{Op: OpStateDir, Err: err}
+	}
+	return dir, nil
+}
+
+// LoadState 讀取提醒狀態檔，檔案不存在時返回空狀態。
+func LoadState(path string) (*State, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return NewState(), nil
+	}
+	if err != nil {
+		return nil, &PathError{Op: OpReadState, Path: path, Err: err}
+	}
+	s := NewState()
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, &PathError{Op: OpParseState, Path: path, Err: err}
+	}
+	// AI 心智註解: 舊檔或手動編輯可能缺少欄位，補上空 map 避免寫入時 panic。
+	if s.Delivered == nil {
+		s.Delivered = map[string]time.Time{}
+	}
+	if s.Snoozed == nil {
+		s.Snoozed = map[string]time.Time{}
+	}
+	return s, nil
+}
+
+// prune 移除已在延後時間送出的延後紀錄，避免狀態檔無限增長。
+func (s *State) prune() {
+	for id, until := range s.Snoozed {
+		if delivered, ok := s.Delivered[id]; ok && !delivered.Before(until) {
+			delete(s.Snoozed, id)
+		}
+	}
+}
+
+// SaveState 將提醒狀態寫入檔案，先寫入暫存檔再改名以避免寫到一半的檔案。
+// 寫入前會移除已送出的延後紀錄。
+func SaveState(path string, s *State) error {
+	s.prune()
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return &PathError{Op: OpWriteState, Path: path, Err: err}
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return &PathError{Op: OpWriteState, Path: path, Err: err}
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return &PathError{Op: OpWriteState, Path: path, Err: err}
+	}
+	return nil
+}
+
+// Snooze 將 reminders 中識別碼為 id 的提醒自 clock 的當前時間起延後 d，並持久化至狀態檔。
+// 找不到該提醒時返回 *NotFoundError，不寫入狀態檔。
+func Snooze(statePath string, reminders []Reminder, id string, d time.Duration, clock Clock) (time.Time, error) {
+	if d <= 0 {
+		return time.Time{}, ErrInvalidSnooze
+	}
+	if !slices.ContainsFunc(reminders, func(r Reminder) bool { return r.ID == id }) {
+		return time.Time{}, &NotFoundError{ID: id}
+	}
+	s, err := LoadState(statePath)
+	if err != nil {
+		return time.Time{}, err
+	}
+	until := clock.Now().Add(d)
+	s.Snooze(id, until)
+	if err := SaveState(statePath, s); err != nil {
+		return time.Time{}, err
+	}
+	return until, nil
+}
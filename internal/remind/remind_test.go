@@ -0,0 +1,151 @@
+// Package remind 提供了提醒收集與狀態管理的單元測試。
+package remind
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+)
+
+// fakeClock 是可手動推進時間的 Clock 實作。
+type fakeClock struct {
+	now   time.Time
+	ticks chan time.Time
+}
+
+// newFakeClock 建立一個停在指定時間的假時鐘。
+func newFakeClock(now time.Time) *fakeClock {
+	return &fakeClock{now: now, ticks: make(chan time.Time)}
+}
+
+// Now 返回假時鐘目前的時間。
+func (c *fakeClock) Now() time.Time { return c.now }
+
+// After 返回由測試手動推進的通道。
+func (c *fakeClock) After(d time.Duration) <-chan time.Time { return c.ticks }
+
+// Advance 推進假時鐘並喚醒等待中的 After。
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+	c.ticks <- c.now
+}
+
+// reminderNotes 返回帶有提醒欄位與待辦到期日的測試筆記。
+func reminderNotes() []*note.Note {
+	return []*note.Note{
+		{
+			Title:     "牙醫",
+			Content:   "記得帶健保卡",
+			CreatedAt: time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC),
+			RemindAt:  time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			Title:     "專案",
+			Content:   "- [ ] 交報告 due:2026-10-20\n- [x] 已完成 due:2026-10-01",
+			CreatedAt: time.Date(2026, 10, 2, 8, 0, 0, 0, time.UTC),
+			Due:       time.Date(2026, 10, 25, 12, 0, 0, 0, time.UTC),
+		},
+	}
+}
+
+// TestCollect 測試能收集筆記與未完成待辦項目的提醒並依時間排序。
+func TestCollect(t *testing.T) {
+	reminders := Collect(reminderNotes())
+	require.Len(t, reminders, 3)
+	assert.Equal(t, "20261001080000", reminders[0].ID)
+	assert.Regexp(t, `^20261002080000:t[0-9a-f]{8}$`, reminders[1].ID)
+	assert.Equal(t, KindTask, reminders[1].Kind)
+	assert.Equal(t, "交報告", reminders[1].Title)
+	assert.Equal(t, "專案", reminders[1].Note)
+	assert.Equal(t, "20261002080000:due", reminders[2].ID)
+	assert.Equal(t, KindDue, reminders[2].Kind)
+	assert.Equal(t, "專案", reminders[2].Title)
+}
+
+// TestCollect_StableTaskID 測試待辦項目的提醒 ID 不受行號影響，但會隨文字或到期日改變。
+func TestCollect_StableTaskID(t *testing.T) {
+	taskID := func(content string) string {
+		n := &note.Note{Title: "專案", Content: content, CreatedAt: time.Date(2026, 10, 2, 8, 0, 0, 0, time.UTC)}
+		reminders := Collect([]*note.Note{n})
+		require.Len(t, reminders, 1)
+		return reminders[0].ID
+	}
+	id := taskID("- [ ] 交報告 due:2026-10-20")
+	assert.Equal(t, id, taskID("新的第一行\n\n- [ ] 交報告 due:2026-10-20"))
+	assert.NotEqual(t, id, taskID("- [ ] 交期末報告 due:2026-10-20"))
+	assert.NotEqual(t, id, taskID("- [ ] 交報告 due:2026-10-21"))
+}
+
+// TestStateDueAndSnooze 測試到期判斷、已送出狀態與延後。
+func TestStateDueAndSnooze(t *testing.T) {
+	reminders := Collect(reminderNotes())
+	s := NewState()
+	now := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+
+	due := s.Due(reminders, now)
+	require.Len(t, due, 1)
+	s.MarkDelivered(due[0])
+	assert.Empty(t, s.Due(reminders, now))
+
+	// 延後一小時後，提醒應在新的時間再次到期。
+	s.Snooze(due[0].ID, now.Add(time.Hour))
+	assert.Empty(t, s.Due(reminders, now.Add(30*time.Minute)))
+	assert.Len(t, s.Due(reminders, now.Add(time.Hour)), 1)
+}
+
+// TestStatePersistence 測試提醒狀態的寫入與讀回。
+func TestStatePersistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "reminders.json")
+
+	s, err := LoadState(path)
+	require.NoError(t, err)
+	assert.Empty(t, s.Delivered)
+
+	clock := newFakeClock(time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC))
+	reminders := Collect(reminderNotes())
+	until, err := Snooze(path, reminders, "20261001080000", time.Hour, clock)
+	require.NoError(t, err)
+	assert.Equal(t, clock.now.Add(time.Hour), until)
+
+	s, err = LoadState(path)
+	require.NoError(t, err)
+	assert.True(t, s.Snoozed["20261001080000"].Equal(until))
+
+	_, err = Snooze(path, reminders, "20261001080000", 0, clock)
+	assert.ErrorIs(t, err, ErrInvalidSnooze)
+
+	// 不存在的提醒返回錯誤且不寫入狀態檔。
+	_, err = Snooze(path, reminders, "bogus", time.Hour, clock)
+	var notFound *NotFoundError
+	require.ErrorAs(t, err, &notFound)
+	assert.Equal(t, "bogus", notFound.ID)
+	s, err = LoadState(path)
+	require.NoError(t, err)
+	assert.NotContains(t, s.Snoozed, "bogus")
+}
+
+// TestSaveState_PrunesDeliveredSnoozes 測試延後的提醒送出後，延後紀錄在寫入時被移除且不會以原本的時間再次送出。
+func TestSaveState_PrunesDeliveredSnoozes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "reminders.json")
+	reminders := Collect(reminderNotes())
+	s := NewState()
+	now := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	r := s.Due(reminders, now)[0]
+	s.MarkDelivered(r)
+	s.Snooze(r.ID, now.Add(time.Hour))
+	require.NoError(t, SaveState(path, s))
+	assert.Contains(t, s.Snoozed, r.ID, "尚未送出的延後紀錄應保留")
+
+	later := now.Add(2 * time.Hour)
+	require.Len(t, s.Due(reminders, later), 1)
+	s.MarkDelivered(r)
+	require.NoError(t, SaveState(path, s))
+	s, err := LoadState(path)
+	require.NoError(t, err)
+	assert.Empty(t, s.Snoozed)
+	assert.Empty(t, s.Due(reminders, later))
+}
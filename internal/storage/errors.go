@@ -0,0 +1,105 @@
+// Package storage 提供了應用程式的資料儲存功能，例如筆記的儲存和讀取。
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// AI 心智註解: storage 只返回具型別的錯誤與不含語系的英文訊息，
+// 顯示給使用者的文字由表示層（CLI、TUI）透過 internal/i18n 翻譯。
+
+// 筆記驗證與 front matter 解析的錯誤。
+var (
+	ErrEmptyContent        = errors.New("note content is empty")
+	ErrNoPath              = errors.New("note has no file path")
+	ErrMissingFrontMatter  = errors.New("missing front matter start marker")
+	ErrUnclosedFrontMatter = errors.New("missing front matter end marker")
+	ErrNoteExists          = errors.New("a note with the same file name already exists")
+)
+
+// Op 表示存取資料目錄或筆記檔案時失敗的操作。
+type Op string
+
+// 存取資料目錄或筆記檔案的操作。
+const (
+	OpDataDir Op = "get data dir"  // 獲取資料目錄。
+	OpReadDir Op = "read data dir" // 走訪資料目錄。
+	OpMkdir   Op = "create folder" // 建立筆記所在的資料夾。
+	OpRead    Op = "read"          // 讀取筆記檔案。
+	OpWrite   Op = "write"         // 寫入筆記檔案。
+	OpRename  Op = "rename"        // 重新命名筆記檔案。
+	OpParse   Op = "parse"         // 解析筆記檔案。
+	OpTrash   Op = "move to trash" // 將筆記檔案移到垃圾桶。
+)
+
+// PathError 表示對資料目錄、資料夾或筆記檔案的操作失敗，Err 為底層錯誤。
+type PathError struct {
+	Op   Op
+	Path string // 失敗的路徑；獲取資料目錄失敗時為空字串。
+	Err  error
+}
+
+func (e *PathError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("%s: %v", e.Op, e.Err)
+	}
+	return fmt.Sprintf("%s %s: %v", e.Op, e.Path, e.Err)
+}
+
+func (e *PathError) Unwrap() error { return e.Err }
+
+// NotFoundError 表示找不到 ID 或標題為 Ref 的筆記。
+type NotFoundError struct {
+	Ref string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("note %q not found", e.Ref)
+}
+
+// InvalidNameError 表示標題或資料夾名稱含有非法字元，無法作為檔案或資料夾名稱。
+type InvalidNameError struct {
+	Folder bool   // 是否為資料夾名稱，否則為標題。
+	Name   string // 無效的標題或資料夾名稱。
+}
+
+func (e *InvalidNameError) Error() string {
+	if e.Folder {
+		return fmt.Sprintf("invalid folder name %q", e.Name)
+	}
+	return fmt.Sprintf("title %q contains characters not allowed in file names (%s)", e.Name, illegalChars)
+}
+
+// FrontMatterError 表示 front matter 的 YAML 格式錯誤，或欄位 Field 的值 Value 無效。
+type FrontMatterError struct {
+	Field string // 無效的欄位；YAML 格式錯誤時為空字串。
+	Value string
+	Err   error // YAML 解析錯誤。
+}
+
+func (e *FrontMatterError) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("invalid front matter: %v", e.Err)
+	}
+	return fmt.Sprintf("invalid front matter field %s: %q", e.Field, e.Value)
+}
+
+func (e *FrontMatterError) Unwrap() error { return e.Err }
+
+// SkippedNotesError 表示載入所有筆記時略過了無法讀取或解析的筆記，Errs 為各筆記的錯誤。
+// 返回此錯誤時，其餘可讀取的筆記仍會一併返回。
+type SkippedNotesError struct {
+	Errs []error
+}
+
+func (e *SkippedNotesError) Error() string {
+	msgs := make([]string, len(e.Errs))
+	for i, err := range e.Errs {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("skipped %d unreadable notes: %s", len(e.Errs), strings.Join(msgs, "; "))
+}
+
+func (e *SkippedNotesError) Unwrap() []error { return e.Errs }
@@ -2,55 +2,71 @@
 package storage
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/wtg42/ora-ora-ora/internal/note"
+	"gopkg.in/yaml.v3"
 )
 
+// frontMatter 對應筆記檔案開頭的 YAML 元資料區塊；欄位順序即寫入檔案時的順序。
+type frontMatter struct {
+	Title     string   `yaml:"title"`
+	CreatedAt string   `yaml:"created_at"`
+	UpdatedAt string   `yaml:"updated_at,omitempty"`
+	RemindAt  string   `yaml:"remind_at,omitempty"`
+	Due       string   `yaml:"due,omitempty"`
+	Source    string   `yaml:"source,omitempty"`
+	Pinned    bool     `yaml:"pinned,omitempty"`
+	Tags      []string `yaml:"tags,flow,omitempty"`
+}
+
+// knownKeys 是 frontMatter 對應的欄位，其他欄位保留於 note.Note.Extra。
+var knownKeys = map[string]bool{
+	"title": true, "created_at": true, "updated_at": true, "remind_at": true,
+	"due": true, "source": true, "pinned": true, "tags": true,
+}
+
+// errStopWalk 用於提前結束 walkNoteFiles 的走訪。
+var errStopWalk = errors.New("stop walking")
+
+// dateLayout 是 front matter 中僅含日期的時間格式。
+const dateLayout = "2006-01-02"
+
 // SaveNote 將給定的筆記儲存到資料目錄中的 Markdown 檔案。
 // 檔案名稱格式為：YYYYMMDDHHmmss-Title.md。
 func SaveNote(n *note.Note) error {
 	// 獲取資料目錄的路徑。
 	dataDir, err := GetDataDir()
 	if err != nil {
-		return fmt.Errorf("獲取資料目錄失敗: %w", err)
+		return &PathError{Op: OpDataDir, Err: err}
 	}
 
-	// 驗證內容不可為空。
-	if strings.TrimSpace(n.Content) == "" {
-		return fmt.Errorf("內容不可為空")
+	if err := validateNote(n); err != nil {
+		return err
 	}
 
-	// 檢查標題中是否存在非法字元，以避免檔案命名問題。
-	illegalChars := "/\\:*?\"<>|"
-	if strings.ContainsAny(n.Title, illegalChars) {
-		return fmt.Errorf("標題包含非法字元，無法作為檔案名稱: %s", n.Title)
+	// 筆記位於子資料夾時先建立該資料夾。
+	dir := filepath.Join(dataDir, filepath.FromSlash(n.Folder))
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return &PathError{Op: OpMkdir, Path: n.Folder, Err: err}
 	}
 
-	// 根據筆記的建立時間和標題生成檔案名稱。
-	filename := fmt.Sprintf("%s-%s.md", n.CreatedAt.Format("20060102150405"), n.Title)
 	// 組合資料目錄和檔案名稱，形成完整的檔案路徑。
-	filePath := filepath.Join(dataDir, filename)
-
-	// 準備筆記內容，包含 YAML 格式的元資料和筆記本文。
-	var contentBuilder strings.Builder
-	contentBuilder.WriteString(fmt.Sprintf("---\n"))
-	contentBuilder.WriteString(fmt.Sprintf("title: \"%s\"\n", n.Title))
-	contentBuilder.WriteString(fmt.Sprintf("created_at: \"%s\"\n", n.CreatedAt.Format("2006-01-02T15:04:05Z07:00")))
-	if len(n.Tags) > 0 {
-		contentBuilder.WriteString(fmt.Sprintf("tags: [%s]\n", strings.Join(n.Tags, ", ")))
-	}
-	contentBuilder.WriteString(fmt.Sprintf("---\n\n"))
-	contentBuilder.WriteString(n.Content)
+	filePath := filepath.Join(dir, noteFilename(n))
 
 	// 將筆記內容寫入檔案。
-	err = os.WriteFile(filePath, []byte(contentBuilder.String()), 0644)
-	if err != nil {
-		return fmt.Errorf("將筆記寫入檔案 %s 失敗: %w", filePath, err)
+	if err := writeNote(filePath, n); err != nil {
+		return err
 	}
+	n.Path = filePath
 
 	return nil
 }
@@ -60,84 +76,523 @@ func ListNotes() ([]string, error) {
 	// 獲取資料目錄的路徑。
 	dataDir, err := GetDataDir()
 	if err != nil {
-		return nil, fmt.Errorf("獲取資料目錄失敗: %w", err)
+		return nil, &PathError{Op: OpDataDir, Err: err}
 	}
 
-	// 讀取資料目錄中的所有檔案。
-	files, err := os.ReadDir(dataDir)
+	// 遞迴讀取資料目錄與子資料夾中的所有筆記檔案。
+	var titles []string
+	err = walkNoteFiles(dataDir, func(path, name string) bool {
+		// 從檔案名稱解析標題：YYYYMMDDHHmmss-Title.md -> Title
+		if _, title, ok := splitFilename(name); ok {
+			titles = append(titles, title)
+		}
+		return true
+	})
 	if err != nil {
-		return nil, fmt.Errorf("讀取資料目錄失敗: %w", err)
+		return nil, err
 	}
 
-	var titles []string
-	for _, file := range files {
-		// 只處理非目錄且以 .md 結尾的檔案。
-		if !file.IsDir() && strings.HasSuffix(file.Name(), ".md") {
-			// 從檔案名稱解析標題：YYYYMMDDHHmmss-Title.md -> Title
-			name := strings.TrimSuffix(file.Name(), ".md")
-			parts := strings.SplitN(name, "-", 2)
-			if len(parts) == 2 {
-				title := parts[1]
-				titles = append(titles, title)
-			}
+	return titles, nil
+}
+
+// LoadAllNotes 讀取並解析資料目錄中所有筆記檔案，返回完整的筆記列表。
+// 無法讀取或解析的筆記會被略過，其錯誤以 *SkippedNotesError 與其餘筆記一併返回。
+func LoadAllNotes() ([]*note.Note, error) {
+	return LoadAllNotesContext(context.Background())
+}
+
+// LoadAllNotesContext 與 LoadAllNotes 相同，但在讀取每個檔案前檢查 ctx，
+// ctx 被取消時停止讀取並返回 ctx.Err()。
+func LoadAllNotesContext(ctx context.Context) ([]*note.Note, error) {
+	// 獲取資料目錄的路徑。
+	dataDir, err := GetDataDir()
+	if err != nil {
+		return nil, &PathError{Op: OpDataDir, Err: err}
+	}
+
+	// AI 心智註解: 單一筆記損毀不應讓整個筆記庫無法使用，略過它並把錯誤交給呼叫端回報。
+	var notes []*note.Note
+	var skipped []error
+	err = walkNoteFiles(dataDir, func(path, name string) bool {
+		if ctx.Err() != nil {
+			return false
+		}
+		n, err := LoadNote(path)
+		if err != nil {
+			skipped = append(skipped, err)
+			return true
 		}
+		notes = append(notes, n)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if len(skipped) > 0 {
+		return notes, &SkippedNotesError{Errs: skipped}
 	}
 
-	return titles, nil
+	return notes, nil
 }
 
 // ReadNote 根據給定的筆記標題，讀取對應的 .md 檔案，解析並移除 YAML front matter，返回筆記的純內容。
 func ReadNote(title string) (string, error) {
+	filePath, err := findNoteFile(func(id, fileTitle string) bool { return fileTitle == title })
+	if err != nil {
+		return "", err
+	}
+	if filePath == "" {
+		return "", &NotFoundError{Ref: title}
+	}
+
+	// 讀取檔案內容。
+	contentBytes, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", &PathError{Op: OpRead, Path: filePath, Err: err}
+	}
+
+	_, body, err := splitFrontMatter(string(contentBytes))
+	if err != nil {
+		return "", err
+	}
+	return body, nil
+}
+
+// FindNotePath 根據筆記 ID（檔名的時間戳記前綴）或標題尋找對應的筆記檔案路徑。
+// ID 完全相符者優先於標題相符者。
+func FindNotePath(ref string) (string, error) {
+	filePath, err := findNoteFile(func(id, title string) bool { return id == ref })
+	if err != nil {
+		return "", err
+	}
+	if filePath == "" {
+		filePath, err = findNoteFile(func(id, title string) bool { return title == ref })
+		if err != nil {
+			return "", err
+		}
+	}
+	if filePath == "" {
+		return "", &NotFoundError{Ref: ref}
+	}
+	return filePath, nil
+}
+
+// ResolveNoteFile 將相對於資料目錄、以 / 分隔的筆記檔案路徑（例如 work/20240501080000-週報.md）轉為完整路徑。
+// 檔案不存在或路徑位於資料目錄之外時返回 *NotFoundError。
+func ResolveNoteFile(file string) (string, error) {
+	rel := filepath.FromSlash(file)
+	if !filepath.IsLocal(rel) || filepath.Ext(rel) != ".md" {
+		return "", &NotFoundError{Ref: file}
+	}
+	dataDir, err := GetDataDir()
+	if err != nil {
+		return "", &PathError{Op: OpDataDir, Err: err}
+	}
+	path := filepath.Join(dataDir, rel)
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return "", &NotFoundError{Ref: file}
+	} else if err != nil {
+		return "", &PathError{Op: OpRead, Path: path, Err: err}
+	}
+	return path, nil
+}
+
+// LoadNote 讀取指定路徑的筆記檔案，解析 front matter 並返回完整的筆記。
+func LoadNote(path string) (*note.Note, error) {
+	contentBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, &PathError{Op: OpRead, Path: path, Err: err}
+	}
+	n, err := parseNote(string(contentBytes))
+	if err != nil {
+		return nil, &PathError{Op: OpParse, Path: filepath.Base(path), Err: err}
+	}
+	n.Path = path
+	n.Folder = folderOf(path)
+	return n, nil
+}
+
+// folderOf 返回筆記檔案相對於資料目錄的資料夾，以 / 分隔；不在資料目錄下時返回空字串。
+func folderOf(path string) string {
+	dataDir, err := GetDataDir()
+	if err != nil {
+		return ""
+	}
+	rel, err := filepath.Rel(dataDir, filepath.Dir(path))
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return ""
+	}
+	return filepath.ToSlash(rel)
+}
+
+// UpdateNote 將筆記覆寫回 n.Path，並把 UpdatedAt 設定為當前時間。
+// 若標題或建立時間變更導致檔名不同，筆記會寫到新的檔名並移除舊檔；
+// 新檔名已被其他筆記使用時返回 ErrNoteExists，不覆寫既有的筆記。
+func UpdateNote(n *note.Note) error {
+	if n.Path == "" {
+		return ErrNoPath
+	}
+	if err := validateNote(n); err != nil {
+		return err
+	}
+
+	n.UpdatedAt = time.Now()
+	newPath := filepath.Join(filepath.Dir(n.Path), noteFilename(n))
+	if newPath == n.Path {
+		return writeNote(n.Path, n)
+	}
+	if info, err := os.Stat(newPath); err == nil {
+		// AI 心智註解: 不分大小寫的檔案系統上，只改變標題大小寫時新舊路徑是同一個檔案，
+		// 此時就地寫入後改名；先寫新檔再刪舊檔會把筆記本身刪掉。
+		old, oldErr := os.Stat(n.Path)
+		if oldErr != nil || !os.SameFile(info, old) {
+			return &PathError{Op: OpRename, Path: n.Path, Err: ErrNoteExists}
+		}
+		if err := writeNote(n.Path, n); err != nil {
+			return err
+		}
+		if err := os.Rename(n.Path, newPath); err != nil {
+			return &PathError{Op: OpRename, Path: n.Path, Err: err}
+		}
+		n.Path = newPath
+		return nil
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return &PathError{Op: OpRename, Path: n.Path, Err: err}
+	}
+
+	// AI 心智註解: 先寫入新檔再移除舊檔，任一步失敗時至少保留一份完整的筆記。
+	if err := writeNote(newPath, n); err != nil {
+		return err
+	}
+	oldPath := n.Path
+	n.Path = newPath
+	if err := os.Remove(oldPath); err != nil {
+		return &PathError{Op: OpRename, Path: oldPath, Err: err}
+	}
+	return nil
+}
+
+// SetPinned 設定指定路徑筆記的釘選狀態並寫回檔案，返回更新後的筆記。
+// 釘選只是整理用的標記，不更新 UpdatedAt；狀態未改變時不寫入檔案。
+func SetPinned(path string, pinned bool) (*note.Note, error) {
+	n, err := LoadNote(path)
+	if err != nil {
+		return nil, err
+	}
+	if n.Pinned == pinned {
+		return n, nil
+	}
+	n.Pinned = pinned
+	if err := writeNote(path, n); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// ReloadEditedNote 在外部編輯器結束後重新解析筆記檔案。
+// 它會驗證 front matter 與內容，更新 UpdatedAt 並寫回檔案；
+// 若使用者破壞了 front matter，則返回錯誤且不修改檔案。
+func ReloadEditedNote(path string) (*note.Note, error) {
+	n, err := LoadNote(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := UpdateNote(n); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// findNoteFile 在資料目錄中尋找第一個符合 match 條件的筆記檔案，找不到時返回空字串。
+func findNoteFile(match func(id, title string) bool) (string, error) {
 	// 獲取資料目錄的路徑。
 	dataDir, err := GetDataDir()
 	if err != nil {
-		return "", fmt.Errorf("獲取資料目錄失敗: %w", err)
+		return "", &PathError{Op: OpDataDir, Err: err}
 	}
 
-	// 讀取資料目錄中的所有檔案，尋找匹配的檔案。
-	files, err := os.ReadDir(dataDir)
+	// 遞迴讀取資料目錄中的所有檔案，尋找匹配的檔案。
+	var found string
+	err = walkNoteFiles(dataDir, func(path, name string) bool {
+		if id, title, ok := splitFilename(name); ok && match(id, title) {
+			found = path
+			return false
+		}
+		return true
+	})
 	if err != nil {
-		return "", fmt.Errorf("讀取資料目錄失敗: %w", err)
+		return "", err
 	}
+	return found, nil
+}
 
-	var filePath string
-	for _, file := range files {
-		if !file.IsDir() && strings.HasSuffix(file.Name(), ".md") {
-			name := strings.TrimSuffix(file.Name(), ".md")
-			parts := strings.SplitN(name, "-", 2)
-			if len(parts) == 2 && parts[1] == title {
-				filePath = filepath.Join(dataDir, file.Name())
-				break
+// walkNoteFiles 遞迴走訪資料目錄中的 .md 檔案，略過以 . 開頭的資料夾（例如 .obsidian）。
+// fn 返回 false 時停止走訪。
+func walkNoteFiles(dataDir string, fn func(path, name string) bool) error {
+	err := filepath.WalkDir(dataDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != dataDir && strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
 			}
+			return nil
 		}
+		if strings.HasSuffix(d.Name(), ".md") && !fn(path, d.Name()) {
+			return errStopWalk
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errStopWalk) {
+		return &PathError{Op: OpReadDir, Path: dataDir, Err: err}
+	}
+	return nil
+}
+
+// splitFilename 將 YYYYMMDDHHmmss-Title.md 格式的檔名拆分為 ID 與標題。
+func splitFilename(name string) (string, string, bool) {
+	parts := strings.SplitN(strings.TrimSuffix(name, ".md"), "-", 2)
+	if len(parts) != 2 {
+		return "", "", false
 	}
+	return parts[0], parts[1], true
+}
 
-	if filePath == "" {
-		return "", fmt.Errorf("找不到標題為 %s 的筆記", title)
+// illegalChars 是標題與資料夾名稱中不可使用的字元，避免檔案命名問題。
+const illegalChars = "/\\:*?\"<>|"
+
+// ValidateTitle 檢查標題是否可以作為檔案名稱，供送出前的即時驗證使用。
+func ValidateTitle(title string) error {
+	if strings.ContainsAny(title, illegalChars) {
+		return &InvalidNameError{Name: title}
 	}
+	return nil
+}
 
-	// 讀取檔案內容。
-	contentBytes, err := os.ReadFile(filePath)
+// validateNote 檢查筆記是否可以被寫入檔案系統。
+func validateNote(n *note.Note) error {
+	// 驗證內容不可為空。
+	if strings.TrimSpace(n.Content) == "" {
+		return ErrEmptyContent
+	}
+
+	// 檢查標題中是否存在非法字元，以避免檔案命名問題。
+	if err := ValidateTitle(n.Title); err != nil {
+		return err
+	}
+
+	return validateFolder(n.Folder)
+}
+
+// validateFolder 檢查資料夾名稱：以 / 分隔，每一層都不可為空、. 開頭或包含非法字元；空字串表示資料目錄本身。
+func validateFolder(folder string) error {
+	if folder == "" {
+		return nil
+	}
+	for _, part := range strings.Split(folder, "/") {
+		if part == "" || strings.HasPrefix(part, ".") || strings.ContainsAny(part, illegalChars) {
+			return &InvalidNameError{Folder: true, Name: folder}
+		}
+	}
+	return nil
+}
+
+// noteFilename 根據筆記的建立時間和標題生成檔案名稱。
+func noteFilename(n *note.Note) string {
+	return fmt.Sprintf("%s-%s.md", n.CreatedAt.Format(note.IDLayout), n.Title)
+}
+
+// writeNote 將筆記格式化後寫入 path。
+func writeNote(path string, n *note.Note) error {
+	content, err := formatNote(n)
+	if err == nil {
+		err = os.WriteFile(path, content, 0644)
+	}
+	if err != nil {
+		return &PathError{Op: OpWrite, Path: path, Err: err}
+	}
+	return nil
+}
+
+// formatNote 準備筆記檔案內容，包含 YAML 格式的元資料和筆記本文。
+// 元資料交由 yaml.v3 編碼，標題、來源或標籤中的引號、冒號、逗號與 # 都會被正確跳脫。
+func formatNote(n *note.Note) ([]byte, error) {
+	fm := frontMatter{
+		Title:     n.Title,
+		CreatedAt: n.CreatedAt.Format(time.RFC3339),
+		Source:    n.Source,
+		Pinned:    n.Pinned,
+		Tags:      n.Tags,
+	}
+	if !n.UpdatedAt.IsZero() {
+		fm.UpdatedAt = n.UpdatedAt.Format(time.RFC3339)
+	}
+	if !n.RemindAt.IsZero() {
+		fm.RemindAt = formatTimeField(n.RemindAt)
+	}
+	if !n.Due.IsZero() {
+		fm.Due = formatTimeField(n.Due)
+	}
+
+	var doc yaml.Node
+	if err := doc.Encode(&fm); err != nil {
+		return nil, err
+	}
+	// AI 心智註解：單值字串一律以雙引號輸出，維持既有檔案的外觀；
+	// 標籤序列維持 flow 樣式，由編碼器在需要時自行加引號（例如含逗號的標籤）。
+	for i := 1; i < len(doc.Content); i += 2 {
+		if value := doc.Content[i]; value.Kind == yaml.ScalarNode && value.Tag == "!!str" {
+			value.Style = yaml.DoubleQuotedStyle
+		}
+	}
+	if n.Extra != "" {
+		var extra yaml.Node
+		if err := yaml.Unmarshal([]byte(n.Extra), &extra); err != nil {
+			return nil, err
+		}
+		if len(extra.Content) == 1 && extra.Content[0].Kind == yaml.MappingNode {
+			doc.Content = append(doc.Content, extra.Content[0].Content...)
+		}
+	}
+	header, err := marshalYAML(&doc)
 	if err != nil {
-		return "", fmt.Errorf("讀取檔案 %s 失敗: %w", filePath, err)
+		return nil, err
 	}
-	content := string(contentBytes)
 
-	// 解析並移除 YAML front matter。
-	// Front matter 位於第一個 --- 和第二個 --- 之間。
+	var contentBuilder strings.Builder
+	contentBuilder.WriteString("---\n")
+	contentBuilder.Write(header)
+	contentBuilder.WriteString("---\n\n")
+	contentBuilder.WriteString(n.Content)
+	return []byte(contentBuilder.String()), nil
+}
+
+// splitFrontMatter 將檔案內容拆分為 front matter 原文與本文。
+// Front matter 位於第一個 --- 和第二個 --- 之間。
+func splitFrontMatter(content string) (string, string, error) {
 	start := strings.Index(content, "---")
 	if start == -1 {
-		return "", fmt.Errorf("檔案格式錯誤：缺少 front matter 起始標記")
+		return "", "", ErrMissingFrontMatter
 	}
 	end := strings.Index(content[start+3:], "---")
 	if end == -1 {
-		return "", fmt.Errorf("檔案格式錯誤：缺少 front matter 結束標記")
+		return "", "", ErrUnclosedFrontMatter
 	}
+	header := content[start+3 : start+3+end]
 	end += start + 3 + 3 // adjust for the second ---
 
 	// 移除 front matter 和前後的換行。
-	pureContent := content[end:]
-	pureContent = strings.TrimLeft(pureContent, "\n")
+	body := strings.TrimLeft(content[end:], "\n")
+	return header, body, nil
+}
+
+// parseNote 解析包含 front matter 的筆記檔案內容。
+func parseNote(content string) (*note.Note, error) {
+	header, body, err := splitFrontMatter(content)
+	if err != nil {
+		return nil, err
+	}
 
-	return pureContent, nil
+	var fm frontMatter
+	if err := yaml.Unmarshal([]byte(header), &fm); err != nil {
+		return nil, &FrontMatterError{Err: err}
+	}
+	extra, err := extraFrontMatter(header)
+	if err != nil {
+		return nil, &FrontMatterError{Err: err}
+	}
+	createdAt, err := time.Parse(time.RFC3339, fm.CreatedAt)
+	if err != nil {
+		return nil, &FrontMatterError{Field: "created_at", Value: fm.CreatedAt}
+	}
+	n := &note.Note{
+		Title:     fm.Title,
+		Content:   body,
+		Tags:      fm.Tags,
+		CreatedAt: createdAt,
+		Source:    fm.Source,
+		Pinned:    fm.Pinned,
+		Extra:     extra,
+	}
+	if fm.UpdatedAt != "" {
+		n.UpdatedAt, err = time.Parse(time.RFC3339, fm.UpdatedAt)
+		if err != nil {
+			return nil, &FrontMatterError{Field: "updated_at", Value: fm.UpdatedAt}
+		}
+	}
+	if fm.RemindAt != "" {
+		n.RemindAt, err = parseTimeField(fm.RemindAt)
+		if err != nil {
+			return nil, &FrontMatterError{Field: "remind_at", Value: fm.RemindAt}
+		}
+	}
+	if fm.Due != "" {
+		n.Due, err = parseTimeField(fm.Due)
+		if err != nil {
+			return nil, &FrontMatterError{Field: "due", Value: fm.Due}
+		}
+	}
+	return n, nil
+}
+
+// extraFrontMatter 返回 front matter 中不屬於 knownKeys 的欄位的 YAML 原文，沒有時返回空字串。
+// AI 心智註解: 以 yaml.Node 保留欄位順序、樣式與註解，釘選、整理標籤或編輯後寫回時不會遺失其他工具加入的欄位。
+func extraFrontMatter(header string) (string, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(header), &doc); err != nil {
+		return "", err
+	}
+	if len(doc.Content) != 1 || doc.Content[0].Kind != yaml.MappingNode {
+		return "", nil
+	}
+	fields := doc.Content[0].Content
+	extra := &yaml.Node{Kind: yaml.MappingNode}
+	for i := 0; i+1 < len(fields); i += 2 {
+		if !knownKeys[fields[i].Value] {
+			extra.Content = append(extra.Content, fields[i], fields[i+1])
+		}
+	}
+	if len(extra.Content) == 0 {
+		return "", nil
+	}
+	out, err := marshalYAML(extra)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// marshalYAML 以兩個空白的縮排編碼 YAML 節點，與手寫的 front matter 慣例一致。
+func marshalYAML(node *yaml.Node) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(node); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// parseTimeField 解析 front matter 中的時間欄位，接受 RFC3339 或 YYYY-MM-DD（本地時間當日零時）。
+func parseTimeField(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.ParseInLocation(dateLayout, value, time.Local)
+}
+
+// formatTimeField 格式化 front matter 中的時間欄位，本地零時的時間只輸出日期。
+func formatTimeField(t time.Time) string {
+	local := t.In(time.Local)
+	if local.Hour() == 0 && local.Minute() == 0 && local.Second() == 0 {
+		return local.Format(dateLayout)
+	}
+	return t.Format(time.RFC3339)
 }
@@ -2,6 +2,7 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/wtg42/ora-ora-ora/internal/note"
 )
 
@@ -24,7 +26,7 @@ func TestSaveNote(t *testing.T) {
 	testCases := []struct {
 		name        string
 		note        *note.Note
-		expectedErr string
+		expectedErr error
 		validate    func(t *testing.T, filePath string)
 	}{
 		{
@@ -35,7 +37,7 @@ func TestSaveNote(t *testing.T) {
 				CreatedAt: time.Date(2023, 1, 15, 10, 30, 0, 0, time.UTC),
 				Tags:      []string{},
 			},
-			expectedErr: "",
+			expectedErr: nil,
 			validate: func(t *testing.T, filePath string) {
 				assert.FileExists(t, filePath)
 				contentBytes, err := os.ReadFile(filePath)
@@ -57,7 +59,7 @@ func TestSaveNote(t *testing.T) {
 				CreatedAt: time.Date(2023, 2, 20, 14, 0, 0, 0, time.UTC),
 				Tags:      []string{"go", "testing", "example"},
 			},
-			expectedErr: "",
+			expectedErr: nil,
 			validate: func(t *testing.T, filePath string) {
 				assert.FileExists(t, filePath)
 				contentBytes, err := os.ReadFile(filePath)
@@ -77,7 +79,7 @@ func TestSaveNote(t *testing.T) {
 				Content:   "這篇筆記不應該被儲存。",
 				CreatedAt: time.Now(),
 			},
-			expectedErr: "標題包含非法字元，無法作為檔案名稱: 無效/標題",
+			expectedErr: &InvalidNameError{Name: "無效/標題"},
 			validate: func(t *testing.T, filePath string) {
 				assert.NoFileExists(t, filePath) // 檔案不應該被建立
 			},
@@ -89,7 +91,7 @@ func TestSaveNote(t *testing.T) {
 				Content:   "空標題筆記。",
 				CreatedAt: time.Now(),
 			},
-			expectedErr: "", // 允許空標題，檔案名稱將類似 YYYYMMDDHHmmss-.md
+			expectedErr: nil, // 允許空標題，檔案名稱將類似 YYYYMMDDHHmmss-.md
 			validate: func(t *testing.T, filePath string) {
 				assert.FileExists(t, filePath)
 				contentBytes, err := os.ReadFile(filePath)
@@ -105,7 +107,7 @@ func TestSaveNote(t *testing.T) {
 				Content:   "",
 				CreatedAt: time.Now(),
 			},
-			expectedErr: "內容不可為空",
+			expectedErr: ErrEmptyContent,
 			validate: func(t *testing.T, filePath string) {
 				assert.NoFileExists(t, filePath) // 檔案不應該被建立
 			},
@@ -119,9 +121,8 @@ func TestSaveNote(t *testing.T) {
 			err := SaveNote(tc.note)
 
 			// 根據預期的錯誤訊息進行斷言。
-			if tc.expectedErr != "" {
-				assert.Error(t, err)
-				assert.Contains(t, err.Error(), tc.expectedErr)
+			if tc.expectedErr != nil {
+				assert.Equal(t, tc.expectedErr, err)
 			} else {
 				assert.NoError(t, err)
 				// 獲取資料目錄並構建預期的檔案路徑進行驗證。
@@ -152,8 +153,10 @@ func TestSaveNote_GetDataDirError(t *testing.T) {
 
 	// 執行 SaveNote 並斷言它返回錯誤。
 	err := SaveNote(n)
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "獲取資料目錄失敗: mocked GetDataDir error")
+	var pathErr *PathError
+	require.ErrorAs(t, err, &pathErr)
+	assert.Equal(t, OpDataDir, pathErr.Op)
+	assert.ErrorIs(t, err, testError)
 }
 
 // TestSaveNote_WriteFileError 測試當寫入檔案失敗時 SaveNote 的行為。
@@ -184,7 +187,379 @@ func TestSaveNote_WriteFileError(t *testing.T) {
 
 	// 執行 SaveNote 並斷言它返回錯誤。
 	err = SaveNote(n)
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "將筆記寫入檔案")
-	// 確切的錯誤訊息可能因作業系統而異，因此只檢查部分訊息。
+	var pathErr *PathError
+	require.ErrorAs(t, err, &pathErr)
+	assert.Equal(t, OpWrite, pathErr.Op)
+	// 確切的底層錯誤可能因作業系統而異，因此只檢查失敗的操作。
+}
+
+// useTempDataHome 將 testDataHome 指向臨時目錄，並在測試結束後還原。
+func useTempDataHome(t *testing.T) string {
+	originalTestDataHome := testDataHome
+	testDataHome = t.TempDir()
+	t.Cleanup(func() { testDataHome = originalTestDataHome })
+
+	dataDir, err := GetDataDir()
+	assert.NoError(t, err)
+	return dataDir
+}
+
+// TestFindNotePath 測試能以 ID 或標題找到筆記檔案。
+func TestFindNotePath(t *testing.T) {
+	dataDir := useTempDataHome(t)
+	n := &note.Note{
+		Title:     "查找測試",
+		Content:   "內容",
+		CreatedAt: time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC),
+	}
+	assert.NoError(t, SaveNote(n))
+	expected := filepath.Join(dataDir, "20240501080000-查找測試.md")
+	assert.Equal(t, expected, n.Path)
+
+	path, err := FindNotePath("20240501080000")
+	assert.NoError(t, err)
+	assert.Equal(t, expected, path)
+
+	path, err = FindNotePath("查找測試")
+	assert.NoError(t, err)
+	assert.Equal(t, expected, path)
+
+	_, err = FindNotePath("不存在")
+	assert.Equal(t, &NotFoundError{Ref: "不存在"}, err)
+}
+
+// TestResolveNoteFile 測試能以相對於資料目錄的路徑找到筆記檔案，拒絕資料目錄之外的路徑。
+func TestResolveNoteFile(t *testing.T) {
+	dataDir := useTempDataHome(t)
+	n := &note.Note{Title: "週報", Content: "內容", Folder: "work", CreatedAt: time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)}
+	require.NoError(t, SaveNote(n))
+
+	path, err := ResolveNoteFile("work/20240501080000-週報.md")
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(dataDir, "work", "20240501080000-週報.md"), path)
+
+	for _, file := range []string{"20240501080000-週報.md", "../notes/work/20240501080000-週報.md", "work/20240501080000-週報"} {
+		_, err = ResolveNoteFile(file)
+		assert.Equal(t, &NotFoundError{Ref: file}, err, file)
+	}
+}
+
+// TestLoadNote 測試能完整解析筆記的 front matter 與內容。
+func TestLoadNote(t *testing.T) {
+	useTempDataHome(t)
+	original := &note.Note{
+		Title:     "完整筆記",
+		Content:   "第一行\n第二行",
+		Tags:      []string{"go", "cli"},
+		CreatedAt: time.Date(2024, 5, 2, 9, 30, 0, 0, time.UTC),
+	}
+	assert.NoError(t, SaveNote(original))
+
+	loaded, err := LoadNote(original.Path)
+	assert.NoError(t, err)
+	assert.Equal(t, original.Title, loaded.Title)
+	assert.Equal(t, original.Content, loaded.Content)
+	assert.Equal(t, original.Tags, loaded.Tags)
+	assert.True(t, original.CreatedAt.Equal(loaded.CreatedAt))
+	assert.True(t, loaded.UpdatedAt.IsZero())
+	assert.Equal(t, original.Path, loaded.Path)
+}
+
+// TestReloadEditedNote 測試外部編輯後重新解析筆記並更新 UpdatedAt。
+func TestReloadEditedNote(t *testing.T) {
+	dataDir := useTempDataHome(t)
+	n := &note.Note{
+		Title:     "編輯前",
+		Content:   "舊內容",
+		CreatedAt: time.Date(2024, 5, 3, 10, 0, 0, 0, time.UTC),
+	}
+	assert.NoError(t, SaveNote(n))
+
+	// 模擬使用者在編輯器中修改標題與內容。
+	edited := "---\ntitle: \"編輯後\"\ncreated_at: \"2024-05-03T10:00:00Z\"\ntags: [edited]\n---\n\n新內容"
+	assert.NoError(t, os.WriteFile(n.Path, []byte(edited), 0644))
+
+	reloaded, err := ReloadEditedNote(n.Path)
+	assert.NoError(t, err)
+	assert.Equal(t, "編輯後", reloaded.Title)
+	assert.Equal(t, "新內容", reloaded.Content)
+	assert.Equal(t, []string{"edited"}, reloaded.Tags)
+	assert.False(t, reloaded.UpdatedAt.IsZero())
+	assert.Equal(t, filepath.Join(dataDir, "20240503100000-編輯後.md"), reloaded.Path)
+	assert.NoFileExists(t, n.Path)
+
+	contentBytes, err := os.ReadFile(reloaded.Path)
+	assert.NoError(t, err)
+	assert.Contains(t, string(contentBytes), "updated_at: ")
+}
+
+// TestSetPinned 測試釘選狀態寫入 front matter 且不更新 UpdatedAt。
+func TestSetPinned(t *testing.T) {
+	useTempDataHome(t)
+	n := &note.Note{
+		Title:     "參考資料",
+		Content:   "常用指令",
+		CreatedAt: time.Date(2024, 5, 5, 9, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, SaveNote(n))
+
+	pinned, err := SetPinned(n.Path, true)
+	require.NoError(t, err)
+	assert.True(t, pinned.Pinned)
+	assert.True(t, pinned.UpdatedAt.IsZero())
+
+	loaded, err := LoadNote(n.Path)
+	require.NoError(t, err)
+	assert.True(t, loaded.Pinned)
+	assert.Equal(t, "常用指令", loaded.Content)
+
+	_, err = SetPinned(n.Path, false)
+	require.NoError(t, err)
+	contentBytes, err := os.ReadFile(n.Path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(contentBytes), "pinned")
+
+	_, err = SetPinned(filepath.Join(filepath.Dir(n.Path), "不存在.md"), true)
+	var pathErr *PathError
+	require.ErrorAs(t, err, &pathErr)
+	assert.Equal(t, OpRead, pathErr.Op)
+}
+
+// TestReloadEditedNote_BrokenFrontMatter 測試使用者破壞 front matter 時返回錯誤且不修改檔案。
+func TestReloadEditedNote_BrokenFrontMatter(t *testing.T) {
+	useTempDataHome(t)
+	n := &note.Note{
+		Title:     "格式錯誤",
+		Content:   "內容",
+		CreatedAt: time.Date(2024, 5, 4, 11, 0, 0, 0, time.UTC),
+	}
+	assert.NoError(t, SaveNote(n))
+
+	broken := "---\ntitle: [未閉合\ncreated_at: \"2024-05-04T11:00:00Z\"\n---\n\n內容"
+	assert.NoError(t, os.WriteFile(n.Path, []byte(broken), 0644))
+
+	_, err := ReloadEditedNote(n.Path)
+	var fmErr *FrontMatterError
+	require.ErrorAs(t, err, &fmErr)
+	assert.Empty(t, fmErr.Field, "YAML 格式錯誤不對應特定欄位")
+
+	contentBytes, err := os.ReadFile(n.Path)
+	assert.NoError(t, err)
+	assert.Equal(t, broken, string(contentBytes))
+}
+
+// TestLoadAllNotes 測試能一次載入資料目錄中的所有筆記。
+func TestLoadAllNotes(t *testing.T) {
+	useTempDataHome(t)
+	assert.NoError(t, SaveNote(&note.Note{Title: "甲", Content: "內容甲", CreatedAt: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)}))
+	assert.NoError(t, SaveNote(&note.Note{Title: "乙", Content: "內容乙", CreatedAt: time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC)}))
+
+	notes, err := LoadAllNotes()
+	assert.NoError(t, err)
+	assert.Len(t, notes, 2)
+	assert.Equal(t, "甲", notes[0].Title)
+	assert.Equal(t, "內容乙", notes[1].Content)
+	assert.NotEmpty(t, notes[1].Path)
+}
+
+// TestLoadAllNotes_SkipsMalformed 測試損毀的筆記會被略過並回報，其餘筆記照常載入與搜尋。
+func TestLoadAllNotes_SkipsMalformed(t *testing.T) {
+	dataDir := useTempDataHome(t)
+	assert.NoError(t, SaveNote(&note.Note{Title: "甲", Content: "內容甲", CreatedAt: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)}))
+	assert.NoError(t, os.WriteFile(filepath.Join(dataDir, "20240601120000-壞.md"), []byte("---\ntitle: \"a\"b\"\n---\n內容"), 0644))
+	assert.NoError(t, SaveNote(&note.Note{Title: "乙", Content: "內容乙", CreatedAt: time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC)}))
+
+	notes, err := LoadAllNotes()
+	var skipped *SkippedNotesError
+	require.ErrorAs(t, err, &skipped)
+	require.Len(t, skipped.Errs, 1)
+	var pathErr *PathError
+	assert.ErrorAs(t, skipped.Errs[0], &pathErr)
+	assert.Equal(t, "20240601120000-壞.md", pathErr.Path)
+	require.Len(t, notes, 2)
+	assert.Equal(t, "甲", notes[0].Title)
+	assert.Equal(t, "乙", notes[1].Title)
+
+	matched, err := SearchContent("內容乙")
+	assert.ErrorAs(t, err, &skipped)
+	require.Len(t, matched, 1)
+	assert.Equal(t, "乙", matched[0].Title)
+}
+
+// TestLoadAllNotesContext_Canceled 測試 context 被取消時停止載入並返回取消錯誤。
+func TestLoadAllNotesContext_Canceled(t *testing.T) {
+	useTempDataHome(t)
+	assert.NoError(t, SaveNote(&note.Note{Title: "甲", Content: "內容甲", CreatedAt: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	notes, err := LoadAllNotesContext(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, notes)
+
+	_, err = SearchContentContext(ctx, "內容")
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+// TestLoadNote_ReminderFields 測試 remind_at 與 due 欄位的解析與寫回。
+func TestLoadNote_ReminderFields(t *testing.T) {
+	dataDir := useTempDataHome(t)
+	path := filepath.Join(dataDir, "20240601000000-提醒.md")
+	raw := "---\ntitle: \"提醒\"\ncreated_at: \"2024-06-01T00:00:00Z\"\nremind_at: 2024-06-02T09:30:00Z\ndue: 2024-06-03\n---\n\n內容"
+	assert.NoError(t, os.WriteFile(path, []byte(raw), 0644))
+
+	n, err := LoadNote(path)
+	assert.NoError(t, err)
+	assert.True(t, n.RemindAt.Equal(time.Date(2024, 6, 2, 9, 30, 0, 0, time.UTC)))
+	assert.True(t, n.Due.Equal(time.Date(2024, 6, 3, 0, 0, 0, 0, time.Local)))
+
+	assert.NoError(t, UpdateNote(n))
+	contentBytes, err := os.ReadFile(n.Path)
+	assert.NoError(t, err)
+	assert.Contains(t, string(contentBytes), "remind_at: \"2024-06-02T09:30:00Z\"")
+	assert.Contains(t, string(contentBytes), "due: \"2024-06-03\"")
+}
+
+// TestUpdateNote_Rename 測試標題變更時改寫到新檔名並移除舊檔，新檔名已被佔用時不覆寫既有筆記。
+func TestUpdateNote_Rename(t *testing.T) {
+	useTempDataHome(t)
+	created := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	n := &note.Note{Title: "舊", Content: "內容", CreatedAt: created}
+	require.NoError(t, SaveNote(n))
+	oldPath := n.Path
+
+	n.Title = "新"
+	require.NoError(t, UpdateNote(n))
+	assert.NoFileExists(t, oldPath)
+	assert.Equal(t, "20240601000000-新.md", filepath.Base(n.Path))
+	loaded, err := LoadNote(n.Path)
+	require.NoError(t, err)
+	assert.Equal(t, "新", loaded.Title)
+
+	other := &note.Note{Title: "其他", Content: "別動我", CreatedAt: created}
+	require.NoError(t, SaveNote(other))
+	path := n.Path
+	n.Title = "其他"
+	err = UpdateNote(n)
+	var pathErr *PathError
+	require.ErrorAs(t, err, &pathErr)
+	assert.Equal(t, OpRename, pathErr.Op)
+	assert.ErrorIs(t, err, ErrNoteExists)
+	assert.Equal(t, path, n.Path)
+	assert.FileExists(t, path)
+	loaded, err = LoadNote(other.Path)
+	require.NoError(t, err)
+	assert.Equal(t, "別動我", loaded.Content)
+}
+
+// TestExtraFrontMatter_Preserved 測試 Ora 不使用的 front matter 欄位在釘選、整理標籤與更新後仍被保留。
+func TestExtraFrontMatter_Preserved(t *testing.T) {
+	dataDir := useTempDataHome(t)
+	path := filepath.Join(dataDir, "20240601000000-別名.md")
+	raw := "---\ntitle: \"別名\"\nid: abc-123\ncreated_at: \"2024-06-01T00:00:00Z\"\naliases:\n  - 暱稱\n  - \"a: b\"\ntags: [x]\n---\n\n內容"
+	require.NoError(t, os.WriteFile(path, []byte(raw), 0644))
+
+	assertExtra := func(path string) {
+		t.Helper()
+		contentBytes, err := os.ReadFile(path)
+		require.NoError(t, err)
+		content := string(contentBytes)
+		assert.Contains(t, content, "id: abc-123\n")
+		assert.Contains(t, content, "aliases:\n  - 暱稱\n  - \"a: b\"\n")
+		_, err = LoadNote(path)
+		require.NoError(t, err)
+	}
+
+	_, err := SetPinned(path, true)
+	require.NoError(t, err)
+	assertExtra(path)
+
+	_, err = SetTags(path, []string{"y"}, []string{"x"})
+	require.NoError(t, err)
+	assertExtra(path)
+
+	n, err := LoadNote(path)
+	require.NoError(t, err)
+	n.Title = "改名"
+	require.NoError(t, UpdateNote(n))
+	assertExtra(n.Path)
+	loaded, err := LoadNote(n.Path)
+	require.NoError(t, err)
+	assert.True(t, loaded.Pinned)
+	assert.Equal(t, []string{"y"}, loaded.Tags)
+}
+
+// TestFormatNote_RoundTrip 測試含引號、冒號、逗號與 # 的標題、標籤與來源在寫入後能原樣解析回來。
+func TestFormatNote_RoundTrip(t *testing.T) {
+	n := &note.Note{
+		Title:     `He said "hi": a, b #1`,
+		Content:   "內容",
+		CreatedAt: time.Date(2024, 7, 1, 8, 0, 0, 0, time.UTC),
+		Source:    `markdown:He said "hi": #1.md`,
+		Pinned:    true,
+		Tags:      []string{"a, b", "c:d", "#e", `"q"`, "[x]"},
+	}
+	content, err := formatNote(n)
+	require.NoError(t, err)
+
+	got, err := parseNote(string(content))
+	require.NoError(t, err)
+	assert.Equal(t, n.Title, got.Title)
+	assert.Equal(t, n.Source, got.Source)
+	assert.Equal(t, n.Tags, got.Tags)
+	assert.True(t, got.Pinned)
+	assert.Equal(t, n.Content, got.Content)
+
+	// 經由 SaveNote 與 LoadNote 寫入磁碟後同樣保持不變。
+	useTempDataHome(t)
+	saved := &note.Note{Title: "來源", Content: "內容", CreatedAt: n.CreatedAt, Source: n.Source, Tags: n.Tags}
+	require.NoError(t, SaveNote(saved))
+	loaded, err := LoadNote(saved.Path)
+	require.NoError(t, err)
+	assert.Equal(t, n.Source, loaded.Source)
+	assert.Equal(t, n.Tags, loaded.Tags)
+}
+
+// TestSaveNote_Folder 測試筆記可存放於子資料夾，並能被列出、查找與載入。
+func TestSaveNote_Folder(t *testing.T) {
+	dataDir := useTempDataHome(t)
+	n := &note.Note{
+		Title:     "子筆記",
+		Content:   "內容",
+		Folder:    "工作/專案",
+		Source:    "obsidian:工作/專案/子筆記.md",
+		CreatedAt: time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC),
+	}
+	assert.NoError(t, SaveNote(n))
+	assert.Equal(t, filepath.Join(dataDir, "工作", "專案", "20240701000000-子筆記.md"), n.Path)
+
+	// 以 . 開頭的資料夾應被略過。
+	hidden := filepath.Join(dataDir, ".obsidian")
+	assert.NoError(t, os.MkdirAll(hidden, 0700))
+	assert.NoError(t, os.WriteFile(filepath.Join(hidden, "20240701000000-隱藏.md"), []byte("x"), 0644))
+
+	titles, err := ListNotes()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"子筆記"}, titles)
+
+	path, err := FindNotePath("20240701000000")
+	assert.NoError(t, err)
+	assert.Equal(t, n.Path, path)
+
+	loaded, err := LoadNote(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "工作/專案", loaded.Folder)
+	assert.Equal(t, "obsidian:工作/專案/子筆記.md", loaded.Source)
+
+	err = SaveNote(&note.Note{Title: "壞", Content: "x", Folder: "../逃脫", CreatedAt: time.Now()})
+	assert.Equal(t, &InvalidNameError{Folder: true, Name: "../逃脫"}, err)
+}
+
+// TestValidateTitle 測試標題驗證與 SaveNote 拒絕的非法字元一致。
+func TestValidateTitle(t *testing.T) {
+	assert.NoError(t, ValidateTitle("會議紀錄 2026-10"))
+	assert.NoError(t, ValidateTitle(""))
+	for _, c := range []string{"/", "\\", ":", "*", "?", "\"", "<", ">", "|"} {
+		assert.Error(t, ValidateTitle("a"+c+"b"), "應拒絕 %q", c)
+	}
 }
@@ -0,0 +1,104 @@
+// Package storage 提供了應用程式的資料儲存功能，例如筆記的儲存和讀取。
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/wtg42/ora-ora-ora/internal/note"
+)
+
+// trashDir 是資料目錄旁存放刪除筆記的子目錄名稱，不在筆記目錄內，因此不會出現在筆記列表中。
+const trashDir = "trash"
+
+// SetTags 為指定路徑的筆記加上 add 中的標籤並移除 remove 中的標籤（不分大小寫），寫回檔案並返回更新後的筆記。
+// 與 SetPinned 相同，整理標籤不更新 UpdatedAt；標籤未改變時不寫入檔案。
+func SetTags(path string, add, remove []string) (*note.Note, error) {
+	n, err := LoadNote(path)
+	if err != nil {
+		return nil, err
+	}
+	has := func(tags []string, tag string) bool {
+		return slices.ContainsFunc(tags, func(t string) bool { return strings.EqualFold(t, tag) })
+	}
+	tags := slices.DeleteFunc(slices.Clone(n.Tags), func(t string) bool { return has(remove, t) })
+	for _, tag := range add {
+		if !has(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	if slices.Equal(tags, n.Tags) {
+		return n, nil
+	}
+	n.Tags = tags
+	if err := writeNote(path, n); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// MoveNote 將指定路徑的筆記移到資料目錄下的 folder 資料夾（以 / 分隔，空字串為資料目錄本身），返回移動後的筆記。
+// 目的地已有同名檔案時返回 ErrNoteExists，不覆寫既有的筆記。
+func MoveNote(path, folder string) (*note.Note, error) {
+	if err := validateFolder(folder); err != nil {
+		return nil, err
+	}
+	n, err := LoadNote(path)
+	if err != nil {
+		return nil, err
+	}
+	if n.Folder == folder {
+		return n, nil
+	}
+	dataDir, err := GetDataDir()
+	if err != nil {
+		return nil, &PathError{Op: OpDataDir, Err: err}
+	}
+	dir := filepath.Join(dataDir, filepath.FromSlash(folder))
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, &PathError{Op: OpMkdir, Path: folder, Err: err}
+	}
+	newPath := filepath.Join(dir, filepath.Base(path))
+	if _, err := os.Stat(newPath); err == nil {
+		return nil, &PathError{Op: OpRename, Path: path, Err: ErrNoteExists}
+	}
+	if err := os.Rename(path, newPath); err != nil {
+		return nil, &PathError{Op: OpRename, Path: path, Err: err}
+	}
+	n.Path, n.Folder = newPath, folder
+	return n, nil
+}
+
+// TrashNote 將指定路徑的筆記移到垃圾桶，返回筆記在垃圾桶中的路徑。
+// 垃圾桶中已有同名檔案時在檔名後加上編號，不覆寫先前刪除的筆記。
+func TrashNote(path string) (string, error) {
+	if _, err := os.Stat(path); err != nil {
+		return "", &PathError{Op: OpRead, Path: path, Err: err}
+	}
+	dir, err := GetAppDataSubDir(trashDir)
+	if err != nil {
+		return "", &PathError{Op: OpDataDir, Err: err}
+	}
+	base := strings.TrimSuffix(filepath.Base(path), ".md")
+	dest := filepath.Join(dir, base+".md")
+	for i := 2; ; i++ {
+		_, err := os.Stat(dest)
+		if errors.Is(err, fs.ErrNotExist) {
+			break
+		}
+		// AI 心智註解: 其他錯誤（例如沒有權限）不會因換個檔名而消失，繼續嘗試只會無限迴圈。
+		if err != nil {
+			return "", &PathError{Op: OpTrash, Path: path, Err: err}
+		}
+		dest = filepath.Join(dir, fmt.Sprintf("%s (%d).md", base, i))
+	}
+	if err := os.Rename(path, dest); err != nil {
+		return "", &PathError{Op: OpTrash, Path: path, Err: err}
+	}
+	return dest, nil
+}
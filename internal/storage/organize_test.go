@@ -0,0 +1,107 @@
+// Package storage 提供了筆記整理功能的單元測試。
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+)
+
+// saveOrganizeNote 在暫存資料目錄中建立一則測試用筆記。
+func saveOrganizeNote(t *testing.T, title, folder string, tags ...string) *note.Note {
+	t.Helper()
+	n := &note.Note{
+		Title:     title,
+		Folder:    folder,
+		Content:   title + " 的內容",
+		Tags:      tags,
+		CreatedAt: time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, SaveNote(n))
+	return n
+}
+
+// TestSetTags 測試標籤的新增與移除不分大小寫、不重複且不更新 UpdatedAt。
+func TestSetTags(t *testing.T) {
+	useTempDataHome(t)
+	n := saveOrganizeNote(t, "週報", "", "Work", "draft")
+
+	updated, err := SetTags(n.Path, []string{"work", "review"}, []string{"DRAFT"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Work", "review"}, updated.Tags)
+	assert.True(t, updated.UpdatedAt.IsZero())
+
+	loaded, err := LoadNote(n.Path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Work", "review"}, loaded.Tags)
+	assert.Equal(t, "週報 的內容", loaded.Content)
+
+	_, err = SetTags(filepath.Join(filepath.Dir(n.Path), "不存在.md"), []string{"x"}, nil)
+	var pathErr *PathError
+	require.ErrorAs(t, err, &pathErr)
+	assert.Equal(t, OpRead, pathErr.Op)
+}
+
+// TestMoveNote 測試筆記移到其他資料夾，且不覆寫目的地的同名筆記。
+func TestMoveNote(t *testing.T) {
+	dataDir := useTempDataHome(t)
+	n := saveOrganizeNote(t, "會議紀錄", "")
+
+	moved, err := MoveNote(n.Path, "work/2024")
+	require.NoError(t, err)
+	assert.Equal(t, "work/2024", moved.Folder)
+	assert.Equal(t, filepath.Join(dataDir, "work", "2024", filepath.Base(n.Path)), moved.Path)
+	assert.NoFileExists(t, n.Path)
+
+	loaded, err := LoadNote(moved.Path)
+	require.NoError(t, err)
+	assert.Equal(t, "work/2024", loaded.Folder)
+	assert.Equal(t, "會議紀錄", loaded.Title)
+
+	back, err := MoveNote(moved.Path, "")
+	require.NoError(t, err)
+	assert.Equal(t, n.Path, back.Path)
+
+	// 目的地已有同名檔案時不移動。
+	require.NoError(t, os.MkdirAll(filepath.Join(dataDir, "archive"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, "archive", filepath.Base(n.Path)), []byte("x"), 0644))
+	_, err = MoveNote(back.Path, "archive")
+	assert.ErrorIs(t, err, ErrNoteExists)
+	assert.FileExists(t, back.Path)
+
+	_, err = MoveNote(back.Path, "../x")
+	var nameErr *InvalidNameError
+	assert.ErrorAs(t, err, &nameErr)
+}
+
+// TestTrashNote 測試筆記移到資料目錄之外的垃圾桶，同名時加上編號而不覆寫。
+func TestTrashNote(t *testing.T) {
+	useTempDataHome(t)
+	first := saveOrganizeNote(t, "草稿", "")
+	dest, err := TrashNote(first.Path)
+	require.NoError(t, err)
+	assert.NoFileExists(t, first.Path)
+	assert.Equal(t, filepath.Base(first.Path), filepath.Base(dest))
+
+	notes, err := LoadAllNotes()
+	require.NoError(t, err)
+	assert.Empty(t, notes)
+
+	second := saveOrganizeNote(t, "草稿", "")
+	dest2, err := TrashNote(second.Path)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Dir(dest), filepath.Dir(dest2))
+	assert.NotEqual(t, dest, dest2)
+	assert.FileExists(t, dest)
+	assert.FileExists(t, dest2)
+
+	_, err = TrashNote(second.Path)
+	var pathErr *PathError
+	require.ErrorAs(t, err, &pathErr)
+	assert.Equal(t, OpRead, pathErr.Op)
+}
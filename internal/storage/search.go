@@ -0,0 +1,34 @@
+// Package storage 提供了應用程式的資料儲存功能，例如筆記的儲存和讀取。
+package storage
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/wtg42/ora-ora-ora/internal/note"
+)
+
+// SearchContent 返回內容包含 text 的筆記，比對時不分大小寫，順序與 LoadAllNotes 相同。
+// text 為空字串時返回所有筆記。
+func SearchContent(text string) ([]*note.Note, error) {
+	return SearchContentContext(context.Background(), text)
+}
+
+// SearchContentContext 與 SearchContent 相同，ctx 被取消時停止讀取並返回 ctx.Err()。
+// 略過無法讀取的筆記時，與 LoadAllNotes 相同地返回符合的筆記與 *SkippedNotesError。
+func SearchContentContext(ctx context.Context, text string) ([]*note.Note, error) {
+	notes, err := LoadAllNotesContext(ctx)
+	var skipped *SkippedNotesError
+	if err != nil && !errors.As(err, &skipped) {
+		return nil, err
+	}
+	needle := strings.ToLower(text)
+	var matched []*note.Note
+	for _, n := range notes {
+		if strings.Contains(strings.ToLower(n.Content), needle) {
+			matched = append(matched, n)
+		}
+	}
+	return matched, err
+}
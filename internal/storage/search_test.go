@@ -0,0 +1,30 @@
+// Package storage 提供了筆記搜尋的單元測試。
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+)
+
+// TestSearchContent 測試依內容搜尋筆記，不分大小寫且不比對標題。
+func TestSearchContent(t *testing.T) {
+	useTempDataHome(t)
+	base := time.Date(2026, 9, 1, 9, 0, 0, 0, time.Local)
+	require.NoError(t, SaveNote(&note.Note{Title: "會議", Content: "討論 Roadmap 與預算", CreatedAt: base}))
+	require.NoError(t, SaveNote(&note.Note{Title: "roadmap", Content: "標題符合但內容不符", CreatedAt: base.Add(time.Minute)}))
+	require.NoError(t, SaveNote(&note.Note{Title: "週報", Content: "ROADMAP 進度", CreatedAt: base.Add(2 * time.Minute)}))
+
+	notes, err := SearchContent("roadmap")
+	require.NoError(t, err)
+	require.Len(t, notes, 2)
+	assert.Equal(t, "會議", notes[0].Title)
+	assert.Equal(t, "週報", notes[1].Title)
+
+	notes, err = SearchContent("不存在")
+	require.NoError(t, err)
+	assert.Empty(t, notes)
+}
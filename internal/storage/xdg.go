@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 )
 
 // appName 定義了應用程式的名稱，用於構建 XDG 相容的路徑。
@@ -18,6 +19,28 @@ var testDataHome string
 // testError 用於測試中模擬 GetDataDir 的錯誤。
 var testError error
 
+// vault 是目前選用的筆記庫；dir 為空時使用預設的資料目錄。
+// AI 心智註解: TUI 切換筆記庫時背景讀取可能同時呼叫 GetDataDir，因此以鎖保護。
+var vault struct {
+	sync.RWMutex
+	name, dir string
+}
+
+// SetVault 選用名稱為 name、存放於 dir 的筆記庫，之後 GetDataDir 返回 dir；
+// dir 為空字串時恢復為預設的資料目錄。查看紀錄、草稿、提醒狀態與垃圾桶不隨筆記庫分開。
+func SetVault(name, dir string) {
+	vault.Lock()
+	defer vault.Unlock()
+	vault.name, vault.dir = name, dir
+}
+
+// CurrentVault 返回目前選用的筆記庫名稱，使用預設的資料目錄時為空字串。
+func CurrentVault() string {
+	vault.RLock()
+	defer vault.RUnlock()
+	return vault.name
+}
+
 // SetTestDataHome 設定測試用的資料目錄路徑，用於單元測試。
 func SetTestDataHome(path string) {
 	testDataHome = path
@@ -28,6 +51,16 @@ func GetTestDataHome() string {
 	return testDataHome
 }
 
+// SetTestConfigHome 設定測試用的配置目錄路徑，用於單元測試。
+func SetTestConfigHome(path string) {
+	testConfigHome = path
+}
+
+// GetTestConfigHome 返回當前的測試配置目錄路徑。
+func GetTestConfigHome() string {
+	return testConfigHome
+}
+
 // GetConfigDir 返回 ~/.config + app 子目錄，用於 TOML 配置檔案。
 // 如果目錄不存在，它會嘗試建立該目錄。
 func GetConfigDir() (string, error) {
@@ -51,9 +84,26 @@ func GetConfigDir() (string, error) {
 	return dir, nil
 }
 
-// GetDataDir 返回 ~/.local/share + app 子目錄 + "notes" 子目錄，用於 Markdown 資料。
+// GetDataDir 返回 ~/.local/share + app 子目錄 + "notes" 子目錄，用於 Markdown 資料；
+// 以 SetVault 選用筆記庫時返回筆記庫的目錄。
 // 如果目錄不存在，它會嘗試建立該目錄。
 func GetDataDir() (string, error) {
+	vault.RLock()
+	dir := vault.dir
+	vault.RUnlock()
+	if dir == "" || testError != nil {
+		return GetAppDataSubDir("notes")
+	}
+	if err := ensureDir(dir); err != nil {
+		return "", fmt.Errorf("vault dir: %w", err)
+	}
+	return dir, nil
+}
+
+// GetAppDataSubDir 返回 ~/.local/share + app 子目錄 + 指定子目錄，
+// 用於筆記以外的應用程式資料，例如提醒狀態。
+// 如果目錄不存在，它會嘗試建立該目錄。
+func GetAppDataSubDir(name string) (string, error) {
 	// 如果設定了 testError，則返回錯誤（用於測試）。
 	if testError != nil {
 		return "", testError
@@ -69,8 +119,8 @@ func GetDataDir() (string, error) {
 		}
 		baseDataHome = filepath.Join(home, ".local", "share")
 	}
-	// 組合基礎資料目錄、應用程式名稱和 "notes" 子目錄，形成完整的資料目錄路徑。
-	dir := filepath.Join(baseDataHome, appName, "notes")
+	// 組合基礎資料目錄、應用程式名稱和子目錄，形成完整的資料目錄路徑。
+	dir := filepath.Join(baseDataHome, appName, name)
 	// 確保目錄存在，如果不存在則建立它。
 	if err := ensureDir(dir); err != nil {
 		return "", fmt.Errorf("data dir: %w", err)
@@ -43,6 +43,26 @@ func TestGetDataDir(t *testing.T) {
 	assert.True(t, filepath.IsAbs(dir))
 }
 
+// TestSetVault 測試選用筆記庫後資料目錄改為筆記庫的目錄，清除後恢復預設的資料目錄。
+func TestSetVault(t *testing.T) {
+	defaultDir := useTempDataHome(t)
+	dir := filepath.Join(t.TempDir(), "work")
+	SetVault("work", dir)
+	t.Cleanup(func() { SetVault("", "") })
+
+	got, err := GetDataDir()
+	assert.NoError(t, err)
+	assert.Equal(t, dir, got)
+	assert.Equal(t, "work", CurrentVault())
+	assert.DirExists(t, dir)
+
+	SetVault("", "")
+	got, err = GetDataDir()
+	assert.NoError(t, err)
+	assert.Equal(t, defaultDir, got)
+	assert.Empty(t, CurrentVault())
+}
+
 // TestEnsureDir 測試 ensureDir 函數是否能正確建立目錄。
 func TestEnsureDir(t *testing.T) {
 	// 建立臨時目錄進行測試，並在測試結束後清理。
@@ -58,3 +78,20 @@ func TestEnsureDir(t *testing.T) {
 	_, err = os.Stat(tempDir)
 	assert.NoError(t, err)
 }
+
+// TestGetAppDataSubDir 測試子目錄會建立在與筆記目錄相同的應用程式資料目錄下。
+func TestGetAppDataSubDir(t *testing.T) {
+	originalTestDataHome := testDataHome
+	testDataHome = t.TempDir()
+	t.Cleanup(func() { testDataHome = originalTestDataHome })
+
+	dir, err := GetAppDataSubDir("state")
+	assert.NoError(t, err)
+	dataDir, err := GetDataDir()
+	assert.NoError(t, err)
+
+	assert.Equal(t, filepath.Dir(dataDir), filepath.Dir(dir))
+	assert.Equal(t, "state", filepath.Base(dir))
+	_, err = os.Stat(dir)
+	assert.NoError(t, err)
+}
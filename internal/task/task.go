@@ -0,0 +1,264 @@
+// Package task 從筆記的 Markdown 內容中解析待辦事項（checklist）。
+package task
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wtg42/ora-ora-ora/internal/note"
+)
+
+// DateLayout 是 due: 標記使用的日期格式。
+const DateLayout = "2006-01-02"
+
+// Priority 代表待辦事項的優先度。
+type Priority int
+
+const (
+	PriorityNone   Priority = iota // 未標記優先度。
+	PriorityLow                    // 以 !low 或 ! 標記。
+	PriorityMedium                 // 以 !medium 或 !! 標記。
+	PriorityHigh                   // 以 !high 或 !!! 標記。
+)
+
+// String 返回優先度的標記文字。
+func (p Priority) String() string {
+	switch p {
+	case PriorityLow:
+		return "!low"
+	case PriorityMedium:
+		return "!medium"
+	case PriorityHigh:
+		return "!high"
+	}
+	return ""
+}
+
+// Task 結構體代表筆記中的單一待辦項目。
+type Task struct {
+	NoteID    string    // 所屬筆記的 ID。
+	NoteTitle string    // 所屬筆記的標題。
+	NotePath  string    // 所屬筆記的檔案路徑。
+	NoteFile  string    // 所屬筆記檔案相對於資料目錄的路徑，以 / 分隔。
+	Line      int       // 在筆記本文中的行號（從 1 開始）。
+	Raw       string    // 核取方塊之後的原始項目文字，含各種標記。
+	Text      string    // 移除標記後的項目文字。
+	Done      bool      // 是否已勾選。
+	Due       time.Time // due: 標記的到期日，未設定則為零值。
+	People    []string  // @person 標記的相關人員。
+	Priority  Priority  // 優先度標記。
+}
+
+// Ref 返回用於 CLI 指令的項目參照，格式為 <筆記檔案>:<行號>:<項目文字的校驗碼>。
+// AI 心智註解: 筆記 ID 只精確到秒，同一秒建立的筆記會撞 ID，因此以相對於資料目錄的檔案路徑指定筆記；
+// 校驗碼讓勾選前能確認該行仍是列出時的項目，筆記在列出後被編輯時不會勾到別的行。
+func (t Task) Ref() string {
+	return fmt.Sprintf("%s:%d:%s", t.NoteFile, t.Line, Checksum(t.Raw))
+}
+
+// Checksum 返回原始項目文字的校驗碼，不含核取方塊，因此勾選前後不變。
+func Checksum(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return fmt.Sprintf("%x", sum[:3])
+}
+
+// ErrNoLine 表示指定的行不存在。
+var ErrNoLine = errors.New("line does not exist")
+
+// ErrNotTask 表示指定的行不是待辦項目。
+var ErrNotTask = errors.New("line is not a task")
+
+// ErrChanged 表示指定的行在列出後已被修改。
+var ErrChanged = errors.New("task changed since it was listed")
+
+// RefError 表示項目參照 Ref 的格式無效。
+type RefError struct {
+	Ref string
+}
+
+func (e *RefError) Error() string {
+	return fmt.Sprintf("invalid task reference %q", e.Ref)
+}
+
+// LineError 表示無法切換第 Line 行的項目；Err 為 ErrNoLine、ErrNotTask 或 ErrChanged。
+type LineError struct {
+	Line int
+	Err  error
+}
+
+func (e *LineError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
+// itemPattern 匹配 Markdown 待辦項目，例如 "- [ ] 買牛奶" 或 "  * [x] 完成"。
+var itemPattern = regexp.MustCompile(`^(\s*[-*+] \[)([ xX])(\]\s+)(.*)$`)
+
+// Parse 解析單一筆記本文中的所有待辦項目，略過圍欄程式碼區塊（``` 或 ~~~）中的內容。
+func Parse(n *note.Note) []Task {
+	var tasks []Task
+	file := ""
+	if n.Path != "" {
+		file = path.Join(n.Folder, filepath.Base(n.Path))
+	}
+	fence := ""
+	for i, line := range strings.Split(n.Content, "\n") {
+		inFence := fence != ""
+		if fence = nextFence(fence, line); inFence || fence != "" {
+			continue
+		}
+		m := itemPattern.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		t := Task{
+			NoteID:    n.ID(),
+			NoteTitle: n.Title,
+			NotePath:  n.Path,
+			NoteFile:  file,
+			Line:      i + 1,
+			Raw:       m[4],
+			Done:      m[2] != " ",
+		}
+		parseMarkers(&t, m[4])
+		tasks = append(tasks, t)
+	}
+	return tasks
+}
+
+// nextFence 依目前所在的圍欄與下一行返回之後所在的圍欄，不在圍欄中時為空字串。
+func nextFence(fence, line string) string {
+	trimmed := strings.TrimSpace(line)
+	if fence == "" {
+		for _, c := range []string{"`", "~"} {
+			if strings.HasPrefix(trimmed, c+c+c) {
+				return trimmed[:len(trimmed)-len(strings.TrimLeft(trimmed, c))]
+			}
+		}
+		return ""
+	}
+	// AI 心智註解: 結尾需為同一種字元且不短於開頭，後面不能接其他文字，否則仍在區塊內。
+	if strings.HasPrefix(trimmed, fence) && strings.Trim(trimmed, fence[:1]) == "" {
+		return ""
+	}
+	return fence
+}
+
+// Collect 彙整多篇筆記中的待辦項目，保持筆記與行號的原始順序。
+func Collect(notes []*note.Note) []Task {
+	var tasks []Task
+	for _, n := range notes {
+		tasks = append(tasks, Parse(n)...)
+	}
+	return tasks
+}
+
+// parseMarkers 從項目文字中抽出 due:、@person 與優先度標記，其餘文字保留為 Text。
+func parseMarkers(t *Task, raw string) {
+	var words []string
+	for _, field := range strings.Fields(raw) {
+		switch {
+		case strings.HasPrefix(field, "due:"):
+			due, err := time.ParseInLocation(DateLayout, strings.TrimPrefix(field, "due:"), time.Local)
+			if err != nil {
+				// AI 心智註解: 無法解析的日期保留在文字中，避免默默吞掉使用者內容。
+				words = append(words, field)
+				continue
+			}
+			t.Due = due
+		case len(field) > 1 && strings.HasPrefix(field, "@"):
+			t.People = append(t.People, strings.TrimPrefix(field, "@"))
+		case priorityOf(field) != PriorityNone:
+			t.Priority = priorityOf(field)
+		default:
+			words = append(words, field)
+		}
+	}
+	t.Text = strings.Join(words, " ")
+}
+
+// priorityOf 將優先度標記轉換為 Priority，非優先度標記返回 PriorityNone。
+func priorityOf(field string) Priority {
+	switch strings.ToLower(field) {
+	case "!high", "!!!":
+		return PriorityHigh
+	case "!medium", "!!":
+		return PriorityMedium
+	case "!low", "!":
+		return PriorityLow
+	}
+	return PriorityNone
+}
+
+// Filter 描述列出待辦項目時的篩選條件。
+type Filter struct {
+	OpenOnly  bool      // 只保留未完成的項目。
+	DueBefore time.Time // 只保留到期日早於此時間的項目，零值表示不篩選。
+}
+
+// Apply 返回符合篩選條件的待辦項目。
+func (f Filter) Apply(tasks []Task) []Task {
+	var result []Task
+	for _, t := range tasks {
+		if f.OpenOnly && t.Done {
+			continue
+		}
+		if !f.DueBefore.IsZero() && (t.Due.IsZero() || !t.Due.Before(f.DueBefore)) {
+			continue
+		}
+		result = append(result, t)
+	}
+	return result
+}
+
+// ParseRef 解析 <筆記檔案>:<行號>:<校驗碼> 格式的項目參照，返回筆記檔案、行號與校驗碼。
+func ParseRef(ref string) (file string, line int, checksum string, err error) {
+	parts := strings.Split(ref, ":")
+	n := len(parts)
+	if n < 3 || parts[n-1] == "" {
+		return "", 0, "", &RefError{Ref: ref}
+	}
+	file = strings.Join(parts[:n-2], ":")
+	line, err = strconv.Atoi(parts[n-2])
+	if err != nil || line < 1 || file == "" {
+		return "", 0, "", &RefError{Ref: ref}
+	}
+	return file, line, parts[n-1], nil
+}
+
+// Toggle 切換本文中指定行的勾選狀態，返回新的本文與切換後的狀態。
+// 該行的項目文字需與校驗碼相符，否則返回 ErrChanged 且不修改本文；圍欄程式碼區塊中的行不是待辦項目。
+func Toggle(content string, line int, checksum string) (string, bool, error) {
+	lines := strings.Split(content, "\n")
+	if line < 1 || line > len(lines) {
+		return content, false, &LineError{Line: line, Err: ErrNoLine}
+	}
+	fence := ""
+	for _, l := range lines[:line-1] {
+		fence = nextFence(fence, l)
+	}
+	m := itemPattern.FindStringSubmatch(lines[line-1])
+	if m == nil || fence != "" {
+		return content, false, &LineError{Line: line, Err: ErrNotTask}
+	}
+	if Checksum(m[4]) != checksum {
+		return content, false, &LineError{Line: line, Err: ErrChanged}
+	}
+	done := m[2] == " "
+	mark := " "
+	if done {
+		mark = "x"
+	}
+	lines[line-1] = m[1] + mark + m[3] + m[4]
+	return strings.Join(lines, "\n"), done, nil
+}
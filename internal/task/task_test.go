@@ -0,0 +1,124 @@
+// Package task 提供了待辦項目解析的單元測試。
+package task
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+)
+
+// testNote 建立一篇包含待辦項目的測試筆記。
+func testNote() *note.Note {
+	return &note.Note{
+		Title: "週會",
+		Content: "# 週會\n" +
+			"- [ ] 準備簡報 due:2026-10-20 @alice !high\n" +
+			"- [x] 寄出會議記錄\n" +
+			"  * [ ] 確認預算 @bob !!\n" +
+			"一般段落 - [ ] 不是項目",
+		CreatedAt: time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC),
+		Path:      "/data/work/20261001090000-週會.md",
+		Folder:    "work",
+	}
+}
+
+// TestParse 測試能解析待辦項目與各種標記。
+func TestParse(t *testing.T) {
+	tasks := Parse(testNote())
+	require.Len(t, tasks, 3)
+
+	first := tasks[0]
+	assert.Equal(t, "work/20261001090000-週會.md:2:"+Checksum("準備簡報 due:2026-10-20 @alice !high"), first.Ref())
+	assert.Equal(t, "/data/work/20261001090000-週會.md", first.NotePath)
+	assert.Equal(t, "週會", first.NoteTitle)
+	assert.Equal(t, "準備簡報", first.Text)
+	assert.False(t, first.Done)
+	assert.Equal(t, "2026-10-20", first.Due.Format(DateLayout))
+	assert.Equal(t, []string{"alice"}, first.People)
+	assert.Equal(t, PriorityHigh, first.Priority)
+
+	assert.True(t, tasks[1].Done)
+	assert.True(t, tasks[1].Due.IsZero())
+
+	assert.Equal(t, 4, tasks[2].Line)
+	assert.Equal(t, "確認預算", tasks[2].Text)
+	assert.Equal(t, PriorityMedium, tasks[2].Priority)
+}
+
+// TestFilterApply 測試未完成與到期日篩選。
+func TestFilterApply(t *testing.T) {
+	tasks := Parse(testNote())
+
+	open := Filter{OpenOnly: true}.Apply(tasks)
+	assert.Len(t, open, 2)
+
+	dueBefore, err := time.ParseInLocation(DateLayout, "2026-10-21", time.Local)
+	require.NoError(t, err)
+	due := Filter{OpenOnly: true, DueBefore: dueBefore}.Apply(tasks)
+	require.Len(t, due, 1)
+	assert.Equal(t, "準備簡報", due[0].Text)
+}
+
+// TestParse_SkipsFencedCode 測試圍欄程式碼區塊中的項目不列為待辦項目，區塊結束後恢復解析。
+func TestParse_SkipsFencedCode(t *testing.T) {
+	n := &note.Note{Content: "```md\n- [ ] 範例\n```\n- [ ] 真的項目\n~~~~\n- [ ] 範例\n~~~\n- [ ] 仍是範例\n~~~~\n- [x] 最後"}
+	tasks := Parse(n)
+	require.Len(t, tasks, 2)
+	assert.Equal(t, "真的項目", tasks[0].Text)
+	assert.Equal(t, 4, tasks[0].Line)
+	assert.Equal(t, "最後", tasks[1].Text)
+
+	_, _, err := Toggle(n.Content, 2, Checksum("範例"))
+	assert.ErrorIs(t, err, ErrNotTask)
+}
+
+// TestParseRef 測試項目參照的解析與錯誤處理。
+func TestParseRef(t *testing.T) {
+	file, line, sum, err := ParseRef("work/20261001090000-a:b.md:2:1a2b3c")
+	assert.NoError(t, err)
+	assert.Equal(t, "work/20261001090000-a:b.md", file)
+	assert.Equal(t, 2, line)
+	assert.Equal(t, "1a2b3c", sum)
+
+	for _, ref := range []string{"20261001090000:2", "a.md:x:1a2b3c", "a.md:2:", ":2:1a2b3c"} {
+		_, _, _, err = ParseRef(ref)
+		assert.Equal(t, &RefError{Ref: ref}, err, ref)
+	}
+}
+
+// TestToggle 測試切換勾選狀態時只改動核取方塊，行的內容與校驗碼不符時不修改。
+func TestToggle(t *testing.T) {
+	content := testNote().Content
+	tasks := Parse(testNote())
+	sum := func(i int) string { return Checksum(tasks[i].Raw) }
+
+	toggled, done, err := Toggle(content, 2, sum(0))
+	assert.NoError(t, err)
+	assert.True(t, done)
+	assert.Contains(t, toggled, "- [x] 準備簡報 due:2026-10-20 @alice !high\n")
+
+	toggled, done, err = Toggle(toggled, 4, sum(2))
+	assert.NoError(t, err)
+	assert.True(t, done)
+	assert.Contains(t, toggled, "  * [x] 確認預算 @bob !!")
+
+	toggled, done, err = Toggle(toggled, 3, sum(1))
+	assert.NoError(t, err)
+	assert.False(t, done)
+	assert.Contains(t, toggled, "- [ ] 寄出會議記錄")
+
+	_, _, err = Toggle(content, 1, sum(0))
+	assert.ErrorIs(t, err, ErrNotTask)
+	_, _, err = Toggle(content, 99, sum(0))
+	assert.ErrorIs(t, err, ErrNoLine)
+
+	// 在項目上方插入一行後，原本的行號指向另一個項目，不可勾選。
+	edited := strings.Replace(content, "# 週會\n", "# 週會\n- [ ] 新的項目\n", 1)
+	unchanged, _, err := Toggle(edited, 2, sum(0))
+	assert.ErrorIs(t, err, ErrChanged)
+	assert.Equal(t, edited, unchanged)
+}
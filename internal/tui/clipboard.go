@@ -0,0 +1,131 @@
+// Package tui 提供了終端使用者介面 (TUI) 的實現。
+package tui
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wtg42/ora-ora-ora/internal/clipboard"
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+)
+
+var (
+	// copyToClipboard 將文字複製到剪貼簿，測試時可替換。
+	copyToClipboard = clipboard.Copy
+	// pasteFromClipboard 讀取剪貼簿中的文字，測試時可替換。
+	pasteFromClipboard = clipboard.Paste
+)
+
+// copiedMsg 訊息表示文字已在背景複製到剪貼簿；status 是成功時狀態列顯示的訊息。
+type copiedMsg struct {
+	status string
+	err    error
+}
+
+// clipboardPastedMsg 訊息表示已在背景讀取剪貼簿，準備貼到建立視圖的表單。
+type clipboardPastedMsg struct {
+	text string
+	err  error
+}
+
+// yankKinds 是複製提示中的按鍵與複製的內容，依提示中的順序排列。
+var yankKinds = []struct {
+	key    string                    // 複製提示中的按鍵。
+	status string                    // 成功時狀態列訊息的訊息鍵，接受筆記標題與複製的文字。
+	text   func(n *note.Note) string // 返回要複製的文字。
+}{
+	{"b", "yank.body", func(n *note.Note) string { return n.Content }},
+	{"t", "yank.title", func(n *note.Note) string { return n.Title }},
+	{"p", "yank.path", func(n *note.Note) string { return n.Path }},
+	{"l", "yank.link", func(n *note.Note) string { return note.Link(n.Title) }},
+}
+
+// copyText 返回將文字複製到剪貼簿的指令，成功時在狀態列顯示 status。
+// OSC 52 序列交給 Bubble Tea 在渲染畫面時一併寫出，本機剪貼簿工具則在背景執行。
+func (m model) copyText(text, status string) tea.Cmd {
+	mode := m.clipboard
+	copyCmd := func() tea.Msg {
+		return copiedMsg{status: status, err: copyToClipboard(mode, text)}
+	}
+	if !clipboard.UsesOSC52(mode) {
+		return copyCmd
+	}
+	// AI 心智註解: tea.Println 的內容由渲染器在同一個鎖內寫在畫面上方，不會與畫面的輸出交錯；
+	// 序列不佔寬度，只留下一行空白。程式沒有使用 alt screen，否則 Println 不會輸出。
+	return tea.Batch(tea.Println(clipboard.TerminalSequence(text)), copyCmd)
+}
+
+// yankTarget 返回複製動作的對象：詳細視圖中顯示的筆記，或列表游標所在的筆記。
+func (m model) yankTarget() *note.Note {
+	if m.currentView == detailView {
+		return m.detailNote
+	}
+	return m.selectedNote()
+}
+
+// startYank 開始複製筆記，等待使用者選擇複製內容、標題、路徑或連結。
+func (m model) startYank() (tea.Model, tea.Cmd) {
+	if m.yankTarget() == nil {
+		return m, nil
+	}
+	m.yanking = true
+	return m, nil
+}
+
+// yankPrompt 返回等待選擇複製內容時取代說明列顯示的提示。
+func (m model) yankPrompt() string {
+	return i18n.T("yank.prompt")
+}
+
+// handleYankPrompt 處理複製提示中的按鍵：b、t、p、l 複製對應的內容，其他按鍵取消。
+func (m model) handleYankPrompt(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	m.yanking = false
+	for _, kind := range yankKinds {
+		if msg.String() == kind.key {
+			return m.yank(kind.key)
+		}
+	}
+	return m, nil
+}
+
+// yank 將複製對象中以按鍵 k 選擇的內容複製到剪貼簿。
+func (m model) yank(k string) (tea.Model, tea.Cmd) {
+	n := m.yankTarget()
+	if n == nil {
+		return m, nil
+	}
+	for _, kind := range yankKinds {
+		if kind.key == k {
+			text := kind.text(n)
+			return m, m.copyText(text, i18n.T(kind.status, n.Title, text))
+		}
+	}
+	return m, nil
+}
+
+// pasteClipboard 返回在背景讀取剪貼簿的指令，讀到的文字貼到建立視圖中取得焦點的欄位。
+func (m model) pasteClipboard() tea.Cmd {
+	mode := m.clipboard
+	return func() tea.Msg {
+		text, err := pasteFromClipboard(mode)
+		return clipboardPastedMsg{text: text, err: err}
+	}
+}
+
+// insertClipboard 將剪貼簿的文字當作括號貼上送給表單，沿用一般貼上的處理：
+// 標題與標籤欄位把換行換成空白，內容欄位原樣插入，大量內容先詢問是否直接存成筆記。
+func (m model) insertClipboard(msg clipboardPastedMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		return m, m.setStatus(statusError, i18n.T("error.paste"), msg.err)
+	}
+	// AI 心智註解: 讀取期間使用者可能已離開建立視圖或正在回答大量貼上的詢問，此時丟棄內容。
+	if m.currentView != createView || m.pendingPaste != "" {
+		return m, nil
+	}
+	text := strings.TrimRight(msg.text, "\n")
+	if text == "" {
+		return m, m.setStatus(statusInfo, "%s", i18n.T("yank.clipboard_empty"))
+	}
+	return m.updateCreateView(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(text), Paste: true})
+}
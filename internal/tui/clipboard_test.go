@@ -0,0 +1,146 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtg42/ora-ora-ora/internal/clipboard"
+)
+
+// fakeClipboard 以記憶體中的字串取代系統剪貼簿，返回目前的內容與最後一次使用的模式。
+func fakeClipboard(t *testing.T) (content *string, mode *clipboard.Mode) {
+	t.Helper()
+	content, mode = new(string), new(clipboard.Mode)
+	originalCopy, originalPaste := copyToClipboard, pasteFromClipboard
+	copyToClipboard = func(m clipboard.Mode, text string) error {
+		*mode, *content = m, text
+		return nil
+	}
+	pasteFromClipboard = func(m clipboard.Mode) (string, error) {
+		*mode = m
+		return *content, nil
+	}
+	t.Cleanup(func() { copyToClipboard, pasteFromClipboard = originalCopy, originalPaste })
+	return content, mode
+}
+
+// TestCopyText 測試複製成功時顯示指定的狀態，失敗時記為錯誤。
+func TestCopyText(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	content, _ := fakeClipboard(t)
+
+	m := loadedModel()
+	m = runCmd(m, m.copyText("內容", "已複製"))
+	assert.Equal(t, "內容", *content)
+	require.NotNil(t, m.status)
+	assert.Equal(t, "已複製", m.status.text)
+
+	copyToClipboard = func(clipboard.Mode, string) error { return errors.New("no tty") }
+	m = runCmd(m, m.copyText("內容", "已複製"))
+	assert.Equal(t, statusError, m.status.level)
+	assert.Equal(t, "複製到剪貼簿失敗: no tty", m.status.text)
+	assert.Len(t, m.errorLog, 1)
+}
+
+// TestCopyText_OSC52 測試 OSC 52 序列交給程式的輸出寫出，只使用本機工具時不輸出序列。
+func TestCopyText_OSC52(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	fakeClipboard(t)
+	t.Setenv("TMUX", "")
+
+	m := loadedModel()
+	m.clipboard = clipboard.ModeOSC52
+	batch, ok := m.copyText("hi", "已複製")().(tea.BatchMsg)
+	require.True(t, ok)
+	require.Len(t, batch, 2)
+	assert.Contains(t, fmt.Sprint(batch[0]()), clipboard.Sequence("hi", false))
+	assert.IsType(t, copiedMsg{}, batch[1]())
+
+	m.clipboard = clipboard.ModeLocal
+	assert.IsType(t, copiedMsg{}, m.copyText("hi", "已複製")())
+}
+
+// TestYank 測試在列表與詳細視圖中按 y 後選擇複製內容、標題、路徑或連結，其他按鍵取消。
+func TestYank(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	content, _ := fakeClipboard(t)
+
+	m := openTestDetail(t, "第一行\n第二行")
+	m = pressRune(m, 'y')
+	assert.True(t, m.yanking)
+	assert.Contains(t, m.detailViewString(), "b 內容")
+	m = pressRune(m, 'b')
+	assert.False(t, m.yanking)
+	assert.Equal(t, "第一行\n第二行", *content)
+	assert.Equal(t, "已複製「Doc」的內容", m.status.text)
+
+	m = pressRune(pressRune(m, 'y'), 'l')
+	assert.Equal(t, "[[Doc]]", *content)
+
+	m = pressRune(pressRune(m, 'y'), 'p')
+	assert.Equal(t, m.detailNote.Path, *content)
+
+	// 列表中作用在游標所在的筆記；其他按鍵只取消提示，不觸發原本的動作。
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEsc})
+	require.Equal(t, listView, m.currentView)
+	m = pressRune(pressRune(m, 'y'), 't')
+	assert.Equal(t, "Doc", *content)
+	m = pressRune(pressRune(m, 'y'), 'n')
+	assert.False(t, m.yanking)
+	assert.Equal(t, listView, m.currentView)
+	assert.Equal(t, "Doc", *content)
+}
+
+// TestPasteClipboard 測試建立視圖中以 Ctrl+V 將剪貼簿的內容貼到取得焦點的欄位。
+func TestPasteClipboard(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	content, mode := fakeClipboard(t)
+
+	setupTestConfig(t, "clipboard = \"local\"\n")
+	m := openForm(t, InitialModel())
+	*content = "會議\n紀錄\n"
+	m = update(m, tea.KeyMsg{Type: tea.KeyCtrlV})
+	assert.Equal(t, clipboard.ModeLocal, *mode)
+	assert.Equal(t, "會議 紀錄", m.titleInput.Value())
+
+	m = pressKey(pressKey(m, tea.KeyMsg{Type: tea.KeyTab}), tea.KeyMsg{Type: tea.KeyTab})
+	*content = "第一行\n第二行"
+	m = update(m, tea.KeyMsg{Type: tea.KeyCtrlV})
+	assert.Equal(t, "第一行\n第二行", m.inputArea.Text())
+
+	// 大量內容沿用大量貼上的詢問。
+	*content = strings.Repeat("x\n", largePasteLines+1)
+	m = update(m, tea.KeyMsg{Type: tea.KeyCtrlV})
+	assert.NotEmpty(t, m.pendingPaste)
+
+	m.pendingPaste = ""
+	*content = ""
+	m = update(m, tea.KeyMsg{Type: tea.KeyCtrlV})
+	assert.Equal(t, "剪貼簿是空的", m.status.text)
+
+	pasteFromClipboard = func(clipboard.Mode) (string, error) { return "", clipboard.ErrNoTool }
+	m = update(m, tea.KeyMsg{Type: tea.KeyCtrlV})
+	assert.Equal(t, statusError, m.status.level)
+	assert.Contains(t, m.status.text, "從剪貼簿貼上失敗")
+}
+
+// TestInitialModel_ClipboardInvalid 測試未知的 clipboard 設定改用 auto 並在狀態列警告。
+func TestInitialModel_ClipboardInvalid(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	setupTestConfig(t, "clipboard = \"x11\"\n")
+
+	m := InitialModel()
+	assert.Equal(t, clipboard.ModeAuto, m.clipboard)
+	require.NotNil(t, m.status)
+	assert.Contains(t, m.status.text, "x11")
+}
@@ -0,0 +1,223 @@
+// Package tui 提供了終端使用者介面 (TUI) 的實現。
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wtg42/ora-ora-ora/internal/draft"
+	"github.com/wtg42/ora-ora-ora/internal/export"
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+)
+
+// command 是列表視圖中可由快捷鍵或命令面板執行的動作。
+// 列表視圖的按鍵分派、說明列與命令面板都從 commands 返回的登錄表取得動作，新增動作時只需登錄一次。
+type command struct {
+	id        string                             // 動作名稱，有快捷鍵時與配置檔案 [keys] 區段的動作名稱相同。
+	title     string                             // 命令面板中顯示與搜尋的說明。
+	binding   func(k keyMap) key.Binding         // 動作的快捷鍵；為 nil 時只能從命令面板執行。
+	column    int                                // 完整說明中所在的欄。
+	short     bool                               // 是否列在簡短說明中。
+	needsNote bool                               // 是否需要列表中選中的筆記，沒有筆記時不執行也不列在命令面板中。
+	run       func(m model) (tea.Model, tea.Cmd) // 執行動作。
+}
+
+// 完整說明中動作所在的欄；第一欄固定是移動游標的按鍵。
+const (
+	columnNotes  = iota + 1 // 開啟、建立、編輯筆記等動作。
+	columnSelect            // 選取筆記與批次動作。
+	columnOrder             // 排序、分組與釘選。
+	columnApp               // 錯誤紀錄、退出等應用程式層級的動作。
+)
+
+// notesExportedMsg 訊息表示列表中的筆記已在背景匯出到檔案。
+type notesExportedMsg struct {
+	path  string
+	count int
+	err   error
+}
+
+// commands 返回列表視圖的動作登錄表，順序即說明列與命令面板中未輸入搜尋文字時的順序。
+func commands() []command {
+	return []command{
+		{id: "open", title: i18n.T("command.open"), binding: func(k keyMap) key.Binding { return k.Open }, column: columnNotes, short: true, needsNote: true,
+			run: func(m model) (tea.Model, tea.Cmd) {
+				// AI 心智註解: 重新讀取檔案，確保顯示待辦勾選等其他視圖寫入後的最新內容。
+				return m, m.openNote(m.selectedNote().Path)
+			}},
+		{id: "filter", title: i18n.T("command.filter"), binding: func(k keyMap) key.Binding { return k.Filter }, column: columnNotes, short: true, run: model.startFilter},
+		{id: "new", title: i18n.T("command.new"), binding: func(k keyMap) key.Binding { return k.New }, column: columnNotes, short: true,
+			run: func(m model) (tea.Model, tea.Cmd) {
+				// AI 心智註解: 及早返回以阻斷當前鍵入事件落入輸入區，避免殘留字元。
+				return m.startComposer(draft.Draft{})
+			}},
+		{id: "edit", title: i18n.T("command.edit"), binding: func(k keyMap) key.Binding { return k.Edit }, column: columnNotes, short: true, needsNote: true,
+			run: func(m model) (tea.Model, tea.Cmd) { return m, m.openEditor(m.selectedNote()) }},
+		{id: "tasks", title: i18n.T("command.tasks"), binding: func(k keyMap) key.Binding { return k.Tasks }, column: columnNotes, short: true, run: model.openTasksView},
+		{id: "drafts", title: i18n.T("command.drafts"), binding: func(k keyMap) key.Binding { return k.Drafts }, column: columnNotes, run: model.openDrafts},
+		{id: "preview", title: i18n.T("command.preview"), binding: func(k keyMap) key.Binding { return k.Preview }, column: columnNotes,
+			run: func(m model) (tea.Model, tea.Cmd) {
+				m.splitPane = !m.splitPane
+				return m, nil
+			}},
+		{id: "yank", title: i18n.T("command.yank"), binding: func(k keyMap) key.Binding { return k.Yank }, column: columnNotes, needsNote: true, run: model.startYank},
+		{id: "copy_body", title: i18n.T("command.copy_body"), column: columnNotes, needsNote: true,
+			run: func(m model) (tea.Model, tea.Cmd) { return m.yank("b") }},
+		{id: "copy_title", title: i18n.T("command.copy_title"), column: columnNotes, needsNote: true,
+			run: func(m model) (tea.Model, tea.Cmd) { return m.yank("t") }},
+		{id: "copy_path", title: i18n.T("command.copy_path"), column: columnNotes, needsNote: true,
+			run: func(m model) (tea.Model, tea.Cmd) { return m.yank("p") }},
+		{id: "copy_link", title: i18n.T("command.copy_link"), column: columnNotes, needsNote: true,
+			run: func(m model) (tea.Model, tea.Cmd) { return m.yank("l") }},
+		{id: "export", title: i18n.T("command.export"), column: columnNotes, run: model.exportListed},
+		{id: "select", title: i18n.T("command.select"), binding: func(k keyMap) key.Binding { return k.Select }, column: columnSelect, needsNote: true, run: model.toggleSelect},
+		{id: "select_range", title: i18n.T("command.select_range"), binding: func(k keyMap) key.Binding { return k.SelectRange }, column: columnSelect, needsNote: true, run: model.toggleVisual},
+		{id: "add_tags", title: i18n.T("command.add_tags"), binding: func(k keyMap) key.Binding { return k.AddTags }, column: columnSelect, needsNote: true,
+			run: func(m model) (tea.Model, tea.Cmd) { return m.startBulk(bulkAddTags) }},
+		{id: "remove_tags", title: i18n.T("command.remove_tags"), binding: func(k keyMap) key.Binding { return k.RemoveTags }, column: columnSelect, needsNote: true,
+			run: func(m model) (tea.Model, tea.Cmd) { return m.startBulk(bulkRemoveTags) }},
+		{id: "move", title: i18n.T("command.move"), binding: func(k keyMap) key.Binding { return k.Move }, column: columnSelect, needsNote: true,
+			run: func(m model) (tea.Model, tea.Cmd) { return m.startBulk(bulkMove) }},
+		{id: "trash", title: i18n.T("command.trash"), binding: func(k keyMap) key.Binding { return k.Trash }, column: columnSelect, needsNote: true,
+			run: func(m model) (tea.Model, tea.Cmd) { return m.startBulk(bulkTrash) }},
+		{id: "copy_selection", title: i18n.T("command.copy_selection"), binding: func(k keyMap) key.Binding { return k.CopySelection }, column: columnSelect, needsNote: true, run: model.copySelection},
+		{id: "sort", title: i18n.T("command.sort"), binding: func(k keyMap) key.Binding { return k.Sort }, column: columnOrder, run: model.cycleSort},
+		{id: "reverse", title: i18n.T("command.reverse"), binding: func(k keyMap) key.Binding { return k.Reverse }, column: columnOrder, run: model.reverseSort},
+		{id: "group", title: i18n.T("command.group"), binding: func(k keyMap) key.Binding { return k.Group }, column: columnOrder, run: model.cycleGroup},
+		{id: "pin", title: i18n.T("command.pin"), binding: func(k keyMap) key.Binding { return k.Pin }, column: columnOrder, needsNote: true,
+			run: func(m model) (tea.Model, tea.Cmd) { return m, m.togglePin(m.selectedNote()) }},
+		{id: "theme", title: i18n.T("command.theme"), column: columnApp, run: model.cycleTheme},
+		{id: "mouse", title: i18n.T("command.mouse"), column: columnApp, run: model.toggleMouse},
+		{id: "error_log", title: i18n.T("command.error_log"), binding: func(k keyMap) key.Binding { return k.ErrorLog }, column: columnApp,
+			run: func(m model) (tea.Model, tea.Cmd) { return m.openErrorLog(), nil }},
+		{id: "quit", title: i18n.T("command.quit"), binding: func(k keyMap) key.Binding { return k.Quit }, column: columnApp,
+			run: func(m model) (tea.Model, tea.Cmd) { return m, tea.Quit }},
+	}
+}
+
+// available 返回動作目前是否可以執行。
+func (c command) available(m model) bool {
+	return !c.needsNote || m.selectedNote() != nil
+}
+
+// runCommandKey 執行列表視圖中快捷鍵對應的動作；按鍵不屬於任何動作時 handled 為 false。
+func (m model) runCommandKey(msg tea.KeyMsg) (_ tea.Model, _ tea.Cmd, handled bool) {
+	for _, c := range commands() {
+		if c.binding == nil || !key.Matches(msg, c.binding(m.keys)) {
+			continue
+		}
+		if !c.available(m) {
+			return m, nil, true
+		}
+		next, cmd := c.run(m)
+		return next, cmd, true
+	}
+	return m, nil, false
+}
+
+// listHelpKeys 依動作登錄表返回列表視圖的說明，動作之外固定列出移動游標、命令面板與說明等按鍵。
+func (m model) listHelpKeys() helpKeys {
+	k := m.keys
+	short := []key.Binding{k.Up, k.Down}
+	full := [][]key.Binding{{k.Up, k.Down}, nil, nil, nil, nil}
+	for _, c := range commands() {
+		if c.binding == nil {
+			continue
+		}
+		b := c.binding(k)
+		if c.short {
+			short = append(short, b)
+		}
+		full[c.column] = append(full[c.column], b)
+	}
+	full[columnOrder] = append(full[columnOrder], k.Jump)
+	full[columnApp] = append(full[columnApp], k.Palette, k.Help, k.ForceQuit)
+	return helpKeys{short: append(short, k.Palette, k.Help, k.Quit), full: full}
+}
+
+// exportListed 在背景將列表中顯示的筆記依目前的順序匯出為單一 Markdown 檔案，寫入資料目錄的 exports 子目錄。
+// 有選取的筆記時改為匯出選取的筆記。
+func (m model) exportListed() (tea.Model, tea.Cmd) {
+	if m.selecting() {
+		return m.exportNotes(m.targets())
+	}
+	var notes []*note.Note
+	for _, item := range m.items {
+		// AI 心智註解: 依標籤分組時同一則筆記可能出現多次，匯出時只保留第一次。
+		if !slices.Contains(notes, item.note) {
+			notes = append(notes, item.note)
+		}
+	}
+	return m.exportNotes(notes)
+}
+
+// exportNotes 在背景將筆記依給定的順序匯出為單一 Markdown 檔案。
+func (m model) exportNotes(notes []*note.Note) (tea.Model, tea.Cmd) {
+	if len(notes) == 0 {
+		return m, m.setStatus(statusInfo, "%s", i18n.T("command.export_empty"))
+	}
+	m.saving++
+	at := time.Now()
+	return m, tea.Batch(func() tea.Msg {
+		path, err := writeExport(notes, at)
+		return notesExportedMsg{path: path, count: len(notes), err: err}
+	}, m.spinner.Tick)
+}
+
+// writeExport 將筆記寫入以匯出時間命名的 Markdown 檔案並返回檔案路徑。
+func writeExport(notes []*note.Note, at time.Time) (string, error) {
+	dir, err := storage.GetAppDataSubDir("exports")
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, "ora-"+at.Format("20060102-150405")+".md")
+	f, err := os.Create(path)
+	if err != nil {
+		return "", fmt.Errorf("create export file: %w", err)
+	}
+	if err := export.WriteBundle(f, notes); err != nil {
+		f.Close()
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", fmt.Errorf("close export file: %w", err)
+	}
+	return path, nil
+}
+
+// cycleTheme 在本次執行中切換到下一個主題，依序為內建主題與配置檔案中的自訂主題，不寫回配置檔案。
+func (m model) cycleTheme() (tea.Model, tea.Cmd) {
+	names := builtinThemeNames()
+	custom := make([]string, 0, len(m.customThemes))
+	for name := range m.customThemes {
+		if _, ok := builtinThemes[name]; !ok {
+			custom = append(custom, name)
+		}
+	}
+	slices.Sort(custom)
+	names = append(names, custom...)
+	current := m.themeName
+	if current == "" {
+		current = themeAuto
+	}
+	next := names[(slices.Index(names, current)+1)%len(names)]
+	// AI 心智註解: 自訂主題有誤時仍記下名稱，下一次切換可越過它而不會停在同一個主題。
+	m.themeName = next
+	s, err := loadStyles(next, m.customThemes)
+	if err != nil {
+		return m, m.setStatus(statusWarning, i18n.T("command.theme_failed"), next, err)
+	}
+	m.styles = s
+	m.inputArea.SetStyles(m.styles.focusedPrompt, m.styles.inputCursor)
+	if m.history != nil {
+		m.historyView.SetContent(renderHistory(m.history, m.historyView.Width, m.styles.history))
+	}
+	return m, m.setStatus(statusInfo, i18n.T("command.theme_switched"), next)
+}
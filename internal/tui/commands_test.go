@@ -0,0 +1,126 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+)
+
+// TestCommands_BindingsAreKeyActions 測試登錄表中有快捷鍵的動作都是配置檔案可覆蓋的動作，且動作名稱不重複。
+func TestCommands_BindingsAreKeyActions(t *testing.T) {
+	k := defaultKeyMap()
+	actions := k.actions()
+	seen := map[string]bool{}
+	for _, c := range commands() {
+		assert.False(t, seen[c.id], "動作名稱重複: %s", c.id)
+		seen[c.id] = true
+		assert.NotEmpty(t, c.title, c.id)
+		if c.binding == nil {
+			continue
+		}
+		b, ok := actions[c.id]
+		require.True(t, ok, "未知的動作: %s", c.id)
+		assert.Equal(t, b.Keys(), c.binding(k).Keys(), c.id)
+	}
+}
+
+// TestListHelpKeys_FollowsRegistry 測試列表視圖的完整說明列出登錄表中所有的快捷鍵，並反映配置檔案的覆蓋。
+func TestListHelpKeys_FollowsRegistry(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	setupTestConfig(t, "[keys]\nsort = [\"o\"]\n")
+
+	m := InitialModel()
+	var helpKeys []string
+	for _, column := range m.viewHelpKeys().FullHelp() {
+		for _, b := range column {
+			helpKeys = append(helpKeys, b.Help().Key)
+		}
+	}
+	for _, c := range commands() {
+		if c.binding != nil {
+			assert.Contains(t, helpKeys, c.binding(m.keys).Help().Key, c.id)
+		}
+	}
+	assert.Contains(t, helpKeys, "o")
+	assert.Contains(t, helpKeys, "ctrl+p")
+	assert.NotContains(t, helpKeys, "s")
+}
+
+// TestRunCommandKey_NeedsNote 測試需要選中筆記的動作在列表為空時不執行。
+func TestRunCommandKey_NeedsNote(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	m := pressRune(loadedModel(), 'e')
+	assert.Equal(t, listView, m.currentView)
+	assert.Nil(t, m.status)
+}
+
+// TestExportListed 測試匯出列表中顯示的筆記到 exports 目錄，並在狀態列顯示檔案路徑。
+func TestExportListed(t *testing.T) {
+	dataDir, teardown := setupTestDataDir(t)
+	defer teardown()
+	seedOrderNotes(t)
+
+	m := typeFilter(t, loadedModel(), "gam")
+	next, cmd := m.exportListed()
+	m = runCmd(next.(model), cmd)
+	require.NotNil(t, m.status)
+	assert.Equal(t, 0, m.saving)
+
+	exportDir, err := storage.GetAppDataSubDir("exports")
+	require.NoError(t, err)
+	assert.Contains(t, exportDir, dataDir)
+	files, err := filepath.Glob(filepath.Join(exportDir, "ora-*.md"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Contains(t, m.status.text, files[0])
+	data, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "## gamma")
+	assert.NotContains(t, string(data), "## beta")
+}
+
+// TestExportListed_Empty 測試列表為空時不建立匯出檔案。
+func TestExportListed_Empty(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	next, cmd := loadedModel().exportListed()
+	m := runCmd(next.(model), cmd)
+	require.NotNil(t, m.status)
+	assert.Equal(t, "列表中沒有可匯出的筆記", m.status.text)
+}
+
+// TestCycleTheme 測試依序切換內建與自訂主題，最後回到原本的主題。
+func TestCycleTheme(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	setupTestConfig(t, "[themes.solar]\nbase = \"light\"\naccent = \"#268bd2\"\n")
+
+	m := InitialModel()
+	var names []string
+	for range 5 {
+		next, _ := m.cycleTheme()
+		m = next.(model)
+		names = append(names, m.themeName)
+	}
+	assert.Equal(t, []string{themeDark, themeHighContrast, themeLight, "solar", themeAuto}, names)
+	assert.Contains(t, m.status.text, themeAuto)
+}
+
+// TestCommands_KeyDispatch 測試列表視圖的按鍵經由登錄表執行動作。
+func TestCommands_KeyDispatch(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	m := pressRune(loadedModel(), 'p')
+	assert.False(t, m.splitPane)
+	m = pressRune(m, 'p')
+	assert.True(t, m.splitPane)
+}
@@ -0,0 +1,216 @@
+// Package tui 提供了終端使用者介面 (TUI) 的實現。
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/glamour"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+)
+
+// renderMarkdown 以 glamour 的標準樣式 style 將 Markdown 渲染為終端文字，width 為自動換行寬度。
+func renderMarkdown(content, style string, width int) (string, error) {
+	r, err := glamour.NewTermRenderer(
+		glamour.WithStandardStyle(style),
+		glamour.WithWordWrap(width),
+	)
+	if err != nil {
+		return "", err
+	}
+	return r.Render(content)
+}
+
+// openDetail 切換到詳細視圖並顯示指定的筆記。
+func (m model) openDetail(n *note.Note) model {
+	m.detailNote = n
+	m.selectedNoteContent = n.Content
+	m.currentView = detailView
+	m.detailRaw = false
+	m.detailSearch = newDetailSearch()
+	m.searching = false
+	m.searchHits = nil
+	m.layoutDetail()
+	m.reader.GotoTop()
+	return m
+}
+
+// closeDetail 離開詳細視圖並回到列表視圖。
+func (m model) closeDetail() model {
+	m.currentView = listView
+	m.selectedNoteContent = ""
+	m.detailNote = nil
+	return m
+}
+
+// renderDetail 依目前模式（渲染或原始碼）重新產生 viewport 內容。
+// AI 心智註解: 渲染失敗時退回原始 Markdown，並在狀態列顯示原因，而不是中斷整個視圖。
+func (m *model) renderDetail() {
+	content := m.selectedNoteContent
+	m.detailErr = ""
+	if !m.detailRaw {
+		rendered, err := renderMarkdown(content, m.styles.markdown, m.reader.Width)
+		if err != nil {
+			m.detailErr = i18n.T("detail.render_failed", err)
+		} else {
+			content = strings.TrimRight(rendered, "\n")
+		}
+	}
+	m.reader.SetContent(content)
+	m.readerLines = strings.Split(ansi.Strip(content), "\n")
+	m.findHits()
+}
+
+// layoutDetail 依終端尺寸調整詳細視圖的 viewport 並重新渲染。
+func (m *model) layoutDetail() {
+	width, height := m.width, m.height
+	if width <= 0 {
+		width = defaultWidth
+	}
+	if height <= 0 {
+		height = defaultHeight
+	}
+	// AI 心智註解: 扣除標題列、搜尋／狀態列與說明列，剩下的高度給 viewport。
+	m.reader.Width = width
+	m.reader.Height = max(height-2-lipgloss.Height(m.helpView()), 1)
+	m.renderDetail()
+}
+
+// newDetailSearch 建立筆記內搜尋的輸入框。
+func newDetailSearch() textinput.Model {
+	ti := textinput.New()
+	ti.Prompt = "/"
+	ti.Placeholder = i18n.T("detail.search_placeholder")
+	return ti
+}
+
+// findHits 依搜尋文字找出符合的行（不分大小寫）。
+func (m *model) findHits() {
+	m.searchHits = nil
+	m.searchIndex = 0
+	query := strings.ToLower(m.detailSearch.Value())
+	if query == "" {
+		return
+	}
+	for i, line := range m.readerLines {
+		if strings.Contains(strings.ToLower(line), query) {
+			m.searchHits = append(m.searchHits, i)
+		}
+	}
+}
+
+// jumpToHit 將 viewport 捲動到第 index 個符合的行。
+func (m *model) jumpToHit(index int) {
+	if len(m.searchHits) == 0 {
+		return
+	}
+	m.searchIndex = (index + len(m.searchHits)) % len(m.searchHits)
+	m.reader.SetYOffset(m.searchHits[m.searchIndex])
+}
+
+// updateDetail 處理詳細視圖的按鍵：捲動、搜尋、切換原始碼與返回。
+func (m model) updateDetail(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// AI 心智註解: 輸入搜尋文字時，除了 Enter 與 Esc 之外的按鍵都交給輸入框。
+	if m.searching {
+		switch {
+		case key.Matches(msg, m.keys.Back):
+			m.searching = false
+			m.detailSearch.Blur()
+			m.detailSearch.SetValue("")
+			m.findHits()
+		case msg.Type == tea.KeyEnter:
+			m.searching = false
+			m.detailSearch.Blur()
+			m.findHits()
+			m.jumpToHit(0)
+		default:
+			var cmd tea.Cmd
+			m.detailSearch, cmd = m.detailSearch.Update(msg)
+			return m, cmd
+		}
+		return m, nil
+	}
+
+	switch {
+	case key.Matches(msg, m.keys.Quit):
+		return m, tea.Quit
+	case key.Matches(msg, m.keys.Help):
+		m.help.ShowAll = !m.help.ShowAll
+		m.layoutDetail()
+	case key.Matches(msg, m.keys.Back):
+		if m.detailSearch.Value() != "" {
+			m.detailSearch.SetValue("")
+			m.findHits()
+			return m, nil
+		}
+		return m.closeDetail(), nil
+	case key.Matches(msg, m.keys.Up):
+		m.reader.ScrollUp(1)
+	case key.Matches(msg, m.keys.Down):
+		m.reader.ScrollDown(1)
+	case key.Matches(msg, m.keys.PageUp):
+		m.reader.PageUp()
+	case key.Matches(msg, m.keys.PageDown):
+		m.reader.PageDown()
+	case key.Matches(msg, m.keys.Search):
+		m.searching = true
+		return m, m.detailSearch.Focus()
+	case key.Matches(msg, m.keys.NextMatch):
+		m.jumpToHit(m.searchIndex + 1)
+	case key.Matches(msg, m.keys.PrevMatch):
+		m.jumpToHit(m.searchIndex - 1)
+	case key.Matches(msg, m.keys.ToggleRaw):
+		m.detailRaw = !m.detailRaw
+		offset := m.reader.YOffset
+		m.renderDetail()
+		m.reader.SetYOffset(offset)
+	case key.Matches(msg, m.keys.Edit):
+		return m, m.openEditor(m.detailNote)
+	case key.Matches(msg, m.keys.Yank):
+		return m.startYank()
+	case key.Matches(msg, m.keys.ErrorLog):
+		return m.openErrorLog(), nil
+	}
+	return m, nil
+}
+
+// detailStatus 返回詳細視圖的狀態列：搜尋輸入、搜尋結果或捲動位置。
+func (m model) detailStatus() string {
+	switch {
+	case m.searching:
+		return m.detailSearch.View()
+	case m.status != nil:
+		return m.statusView()
+	case m.detailErr != "":
+		return m.styles.history[roleError].Render(m.detailErr)
+	case m.detailSearch.Value() != "" && len(m.searchHits) == 0:
+		return i18n.T("detail.no_match", m.detailSearch.Value())
+	case m.detailSearch.Value() != "":
+		return i18n.T("detail.match", m.detailSearch.Value(), m.searchIndex+1, len(m.searchHits))
+	}
+	mode := i18n.T("detail.rendered")
+	if m.detailRaw {
+		mode = i18n.T("detail.raw")
+	}
+	return fmt.Sprintf("%s・%3.f%%", mode, m.reader.ScrollPercent()*100)
+}
+
+// detailViewString 渲染詳細視圖：標題列、可捲動的筆記內容、狀態列與說明列。
+func (m model) detailViewString() string {
+	title := ""
+	if m.detailNote != nil {
+		title = m.detailNote.Title
+	}
+	return lipgloss.JoinVertical(lipgloss.Left,
+		m.styles.header.Render(i18n.T("detail.header", title))+m.busyIndicator(),
+		m.reader.View(),
+		m.detailStatus(),
+		m.helpView(),
+	)
+}
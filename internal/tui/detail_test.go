@@ -0,0 +1,101 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// openTestDetail 建立一則筆記並在指定終端尺寸下開啟詳細視圖。
+func openTestDetail(t *testing.T, content string) model {
+	require.NoError(t, writeTestNote("Doc", content))
+	m := loadedModel()
+	updatedModel, _ := m.Update(tea.WindowSizeMsg{Width: 60, Height: 15})
+	m = updatedModel.(model)
+	m = update(m, tea.KeyMsg{Type: tea.KeyEnter})
+	require.Equal(t, detailView, m.currentView)
+	return m
+}
+
+// pressKey 送出單一按鍵並返回更新後的 model。
+func pressKey(m model, msg tea.KeyMsg) model {
+	updatedModel, _ := m.Update(msg)
+	return updatedModel.(model)
+}
+
+func TestDetailView_RendersMarkdownAndToggleRaw(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	m := openTestDetail(t, "# 標題\n\n- 項目一\n\n```go\nfunc main() {}\n```")
+	view := ansi.Strip(m.View())
+	assert.Contains(t, view, "標題")
+	assert.NotContains(t, view, "# 標題", "渲染後不應顯示 Markdown 標記")
+	assert.Contains(t, view, "• 項目一")
+	assert.Contains(t, view, "func main() {}")
+	assert.Equal(t, 15, lipgloss.Height(m.View()))
+
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+	view = ansi.Strip(m.View())
+	assert.Contains(t, view, "# 標題")
+	assert.Contains(t, view, "```go")
+	assert.Contains(t, view, "原始碼")
+}
+
+func TestDetailView_ScrollAndSearch(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	var lines []string
+	for i := 1; i <= 60; i++ {
+		lines = append(lines, fmt.Sprintf("第 %d 行", i))
+	}
+	lines[39] = "這裡有 needle"
+	lines[49] = "另一個 Needle"
+	m := openTestDetail(t, strings.Join(lines, "\n\n"))
+
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyPgDown})
+	assert.Greater(t, m.reader.YOffset, 0)
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyPgUp})
+	assert.Equal(t, 0, m.reader.YOffset)
+
+	// AI 心智註解: 搜尋輸入中的 'n'、'r' 等按鍵屬於搜尋文字，不觸發快捷鍵。
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+	require.True(t, m.searching)
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("needle")})
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEnter})
+	require.Len(t, m.searchHits, 2)
+	assert.Equal(t, m.searchHits[0], m.reader.YOffset)
+	assert.Contains(t, m.readerLines[m.reader.YOffset], "needle")
+	assert.Contains(t, ansi.Strip(m.View()), "1/2")
+
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	assert.Equal(t, 1, m.searchIndex)
+	assert.Contains(t, m.readerLines[m.reader.YOffset], "Needle")
+
+	// AI 心智註解: 第一次 Esc 清除搜尋，第二次才返回列表。
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, detailView, m.currentView)
+	assert.Empty(t, m.searchHits)
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, listView, m.currentView)
+}
+
+func TestDetailView_ResizeRewraps(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	m := openTestDetail(t, strings.Repeat("很長的段落內容 ", 30))
+	narrow := len(m.readerLines)
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'?'}})
+	updatedModel, _ := m.Update(tea.WindowSizeMsg{Width: 120, Height: 15})
+	m = updatedModel.(model)
+	assert.Less(t, len(m.readerLines), narrow)
+	assert.Equal(t, 15, lipgloss.Height(m.View()))
+}
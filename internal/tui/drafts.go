@@ -0,0 +1,223 @@
+// Package tui 提供了終端使用者介面 (TUI) 的實現。
+package tui
+
+import (
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wtg42/ora-ora-ora/internal/draft"
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+)
+
+// draftInterval 是建立視圖自動儲存草稿的間隔。
+const draftInterval = 2 * time.Second
+
+// draftTickMsg 訊息表示自動儲存草稿的時間已到。
+type draftTickMsg struct {
+	id string // 發出計時時的草稿識別碼，草稿已更換時停止計時。
+}
+
+// draftSavedMsg 訊息表示草稿已在背景寫入或刪除。
+type draftSavedMsg struct {
+	quit bool // 寫入後是否退出程式。
+	err  error
+}
+
+// draftsLoadedMsg 訊息表示草稿列表已在背景載入。
+type draftsLoadedMsg struct {
+	drafts  []draft.Draft
+	startup bool // 是否為啟動時的檢查，是則提示還原未送出的草稿。
+	err     error
+}
+
+// draftTick 返回在 draftInterval 後觸發自動儲存的指令。
+func draftTick(id string) tea.Cmd {
+	return tea.Tick(draftInterval, func(time.Time) tea.Msg { return draftTickMsg{id: id} })
+}
+
+// startComposer 切換到建立視圖，以草稿填入表單並開始自動儲存。
+// 草稿沒有識別碼時建立新的草稿，否則延續指定的草稿。
+func (m model) startComposer(d draft.Draft) (model, tea.Cmd) {
+	if d.ID == "" {
+		d.ID = draft.NewID(time.Now())
+	}
+	m.currentView = createView
+	m.newNoteTitle = ""
+	m.newNoteContent = ""
+	focus := m.fillForm(d)
+	m.draftID = d.ID
+	m.draftText = m.formDraft().Encode()
+	m.loadHistory()
+	return m, tea.Batch(focus, draftTick(d.ID))
+}
+
+// saveDraft 返回將表單寫入草稿的指令；內容未變更時返回 nil，所有欄位清空時刪除草稿。
+func (m *model) saveDraft() tea.Cmd {
+	d := m.formDraft()
+	text := d.Encode()
+	if m.draftID == "" || text == m.draftText {
+		return nil
+	}
+	m.draftText = text
+	return func() tea.Msg {
+		if d.Blank() {
+			return draftSavedMsg{err: draft.Delete(d.ID)}
+		}
+		return draftSavedMsg{err: draft.Save(d)}
+	}
+}
+
+// saveDraftAndQuit 返回先寫入草稿再退出程式的指令。
+func (m *model) saveDraftAndQuit() tea.Cmd {
+	save := m.saveDraft()
+	if save == nil {
+		return tea.Quit
+	}
+	return func() tea.Msg {
+		msg := save().(draftSavedMsg)
+		msg.quit = true
+		return msg
+	}
+}
+
+// discardDraft 返回刪除目前草稿的指令，並為表單接下來的內容建立新的草稿。
+func (m *model) discardDraft() tea.Cmd {
+	id := m.draftID
+	m.draftID = draft.NewID(time.Now())
+	m.draftText = m.formDraft().Encode()
+	return tea.Batch(func() tea.Msg {
+		return draftSavedMsg{err: draft.Delete(id)}
+	}, draftTick(m.draftID))
+}
+
+// loadDrafts 返回在背景載入草稿列表的指令。
+func loadDrafts(startup bool) tea.Cmd {
+	return func() tea.Msg {
+		drafts, err := draft.List()
+		return draftsLoadedMsg{drafts: drafts, startup: startup, err: err}
+	}
+}
+
+// deleteDraft 返回刪除指定草稿並重新載入草稿列表的指令。
+func deleteDraft(id string) tea.Cmd {
+	return func() tea.Msg {
+		if err := draft.Delete(id); err != nil {
+			return draftsLoadedMsg{err: err}
+		}
+		drafts, err := draft.List()
+		return draftsLoadedMsg{drafts: drafts, err: err}
+	}
+}
+
+// updateDraftMsg 處理草稿相關的訊息；不屬於這些訊息時 handled 為 false。
+func (m model) updateDraftMsg(msg tea.Msg) (_ model, _ tea.Cmd, handled bool) {
+	switch msg := msg.(type) {
+	case draftTickMsg:
+		// AI 心智註解: 離開建立視圖或換了草稿後停止計時，離開時已由 leaveComposer 寫入最後的內容。
+		if m.currentView != createView || msg.id != m.draftID {
+			return m, nil, true
+		}
+		return m, tea.Batch(m.saveDraft(), draftTick(msg.id)), true
+
+	case draftSavedMsg:
+		if msg.quit {
+			return m, tea.Quit, true
+		}
+		if msg.err != nil {
+			// AI 心智註解: 寫入失敗時清除記錄的內容，下一次計時會重試。
+			m.draftText = ""
+			return m, m.setStatus(statusWarning, i18n.T("draft.autosave_failed"), i18n.Error(msg.err)), true
+		}
+		return m, nil, true
+
+	case draftsLoadedMsg:
+		if msg.err != nil {
+			return m, m.setStatus(statusError, i18n.T("draft.load_failed"), i18n.Error(msg.err)), true
+		}
+		m.drafts = msg.drafts
+		m.draftCursor = max(min(m.draftCursor, len(m.drafts)-1), 0)
+		if msg.startup {
+			m.draftNotice = len(m.drafts) > 0
+		}
+		return m, nil, true
+	}
+	return m, nil, false
+}
+
+// leaveComposer 離開建立視圖回到列表，並寫入尚未自動儲存的內容。
+func (m model) leaveComposer() (model, tea.Cmd) {
+	cmd := m.saveDraft()
+	m.currentView = listView
+	return m, cmd
+}
+
+// openDrafts 切換到草稿視圖並在背景載入草稿列表。
+func (m model) openDrafts() (tea.Model, tea.Cmd) {
+	m.currentView = draftsView
+	m.draftNotice = false
+	return m, loadDrafts(false)
+}
+
+// updateDrafts 處理草稿視圖的按鍵：Enter 還原到建立視圖，刪除鍵捨棄草稿。
+func (m model) updateDrafts(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Quit):
+		return m, tea.Quit
+	case key.Matches(msg, m.keys.Help):
+		m.help.ShowAll = !m.help.ShowAll
+	case key.Matches(msg, m.keys.Back):
+		m.currentView = listView
+	case key.Matches(msg, m.keys.Up):
+		if m.draftCursor > 0 {
+			m.draftCursor--
+		}
+	case key.Matches(msg, m.keys.Down):
+		if m.draftCursor < len(m.drafts)-1 {
+			m.draftCursor++
+		}
+	case key.Matches(msg, m.keys.Open):
+		if len(m.drafts) > 0 {
+			return m.startComposer(m.drafts[m.draftCursor])
+		}
+	case key.Matches(msg, m.keys.Discard):
+		if len(m.drafts) > 0 {
+			return m, deleteDraft(m.drafts[m.draftCursor].ID)
+		}
+	}
+	return m, nil
+}
+
+// draftNoticeView 返回列表視圖中提示還原草稿的文字，沒有需要提示的草稿時返回空字串。
+func (m model) draftNoticeView() string {
+	if !m.draftNotice {
+		return ""
+	}
+	return m.styles.status[statusWarning].Render(i18n.T("draft.notice", len(m.drafts), m.keys.Drafts.Help().Key))
+}
+
+// draftsViewString 渲染草稿列表，最近更新的在最上方。
+func (m model) draftsViewString() string {
+	var b strings.Builder
+	b.WriteString(m.styles.header.Render(i18n.T("draft.header")) + "\n\n")
+	if len(m.drafts) == 0 {
+		b.WriteString(i18n.T("draft.empty") + "\n")
+	}
+	for i, d := range m.drafts {
+		cursor := " "
+		if m.draftCursor == i {
+			cursor = m.styles.cursor.Render(">")
+		}
+		title := d.Title
+		if title == "" {
+			title = i18n.T("draft.untitled")
+		}
+		if tags := parseTags(d.Tags); len(tags) > 0 {
+			title += "  #" + strings.Join(tags, " #")
+		}
+		b.WriteString(i18n.T("draft.entry", cursor, d.UpdatedAt.Format("2006-01-02 15:04"), title, len([]rune(d.Body))) + "\n")
+	}
+	b.WriteString("\n" + m.footerView() + "\n")
+	return b.String()
+}
@@ -0,0 +1,103 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtg42/ora-ora-ora/internal/draft"
+)
+
+// listDrafts 返回草稿目錄中的所有草稿。
+func listDrafts(t *testing.T) []draft.Draft {
+	drafts, err := draft.List()
+	require.NoError(t, err)
+	return drafts
+}
+
+// TestDrafts_Autosave 測試計時到期時寫入草稿，內容未變更時不重複寫入，離開建立視圖時寫入最後的內容。
+func TestDrafts_Autosave(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	m := fillFormFields(openForm(t, loadedModel()), "標題", "work", "第一行")
+	// AI 心智註解: draftTick 要等 draftInterval，runCmd 會略過計時指令，因此手動送出計時訊息。
+	m = update(m, draftTickMsg{id: m.draftID})
+	drafts := listDrafts(t)
+	require.Len(t, drafts, 1)
+	assert.Equal(t, "標題", drafts[0].Title)
+	assert.Equal(t, "work", drafts[0].Tags)
+	assert.Equal(t, "第一行", drafts[0].Body)
+
+	_, cmd := m.Update(draftTickMsg{id: m.draftID})
+	assert.NotNil(t, cmd, "應繼續排程下一次計時")
+	assert.Nil(t, m.saveDraft(), "內容未變更時不應寫入")
+
+	m = pressKey(m, typeText("，繼續"))
+	m = update(m, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, listView, m.currentView)
+	drafts = listDrafts(t)
+	require.Len(t, drafts, 1)
+	assert.Equal(t, "第一行，繼續", drafts[0].Body)
+}
+
+// TestDrafts_QuitFlushes 測試在建立視圖中 Ctrl+C 退出前寫入草稿。
+func TestDrafts_QuitFlushes(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	m := pressKey(openForm(t, loadedModel()), typeText("來不及送出"))
+	m = update(m, tea.KeyMsg{Type: tea.KeyCtrlC})
+	drafts := listDrafts(t)
+	require.Len(t, drafts, 1)
+	assert.Equal(t, "來不及送出", drafts[0].Title)
+}
+
+// TestDrafts_RestoreOnStartup 測試啟動時提示未送出的草稿，並能從草稿視圖還原或捨棄。
+func TestDrafts_RestoreOnStartup(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	require.NoError(t, draft.Save(draft.Draft{ID: "20261019-090000.000", Title: "舊草稿"}))
+	require.NoError(t, draft.Save(draft.Draft{ID: "20261019-100000.000", Title: "要還原的草稿", Tags: "idea", Body: "內容"}))
+
+	m := loadedModel()
+	assert.Contains(t, m.View(), "有 2 份未送出的草稿")
+
+	m = update(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'D'}})
+	require.Equal(t, draftsView, m.currentView)
+	assert.NotContains(t, m.View(), "未送出的草稿，按")
+	assert.Contains(t, m.View(), "要還原的草稿  #idea")
+	require.Len(t, m.drafts, 2)
+
+	// AI 心智註解: 兩份草稿的修改時間可能相同，依識別碼找出要捨棄的一份。
+	for m.drafts[m.draftCursor].ID != "20261019-090000.000" {
+		m = pressKey(m, tea.KeyMsg{Type: tea.KeyDown})
+	}
+	m = update(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+	require.Len(t, m.drafts, 1)
+
+	m = update(m, tea.KeyMsg{Type: tea.KeyEnter})
+	require.Equal(t, createView, m.currentView)
+	assert.Equal(t, "要還原的草稿", m.titleInput.Value())
+	assert.Equal(t, "idea", m.tagsInput.Value())
+	assert.Equal(t, "內容", m.inputArea.Text())
+	assert.Equal(t, fieldBody, m.formFocus, "已有標題時焦點應放在內容")
+	assert.Equal(t, "20261019-100000.000", m.draftID, "還原後應延續同一份草稿")
+}
+
+// TestDrafts_DiscardedOnSubmit 測試筆記成功儲存後刪除對應的草稿。
+func TestDrafts_DiscardedOnSubmit(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	m := fillFormFields(openForm(t, loadedModel()), "草稿標題", "", "內容")
+	m = update(m, draftTickMsg{id: m.draftID})
+	old := m.draftID
+	require.Len(t, listDrafts(t), 1)
+
+	m = update(m, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Empty(t, listDrafts(t))
+	assert.NotEqual(t, old, m.draftID, "送出後的新內容應使用新的草稿")
+}
@@ -0,0 +1,316 @@
+// Package tui 提供了終端使用者介面 (TUI) 的實現。
+package tui
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/wtg42/ora-ora-ora/internal/draft"
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+)
+
+// formField 表示建立視圖表單中的欄位。
+type formField int
+
+const (
+	fieldTitle formField = iota // 標題。
+	fieldTags                   // 標籤，以逗號分隔。
+	fieldBody                   // 內容，使用 InputArea。
+	fieldCount                  // 欄位數量，用於循環切換焦點。
+)
+
+// maxTagSuggestions 是標籤欄位最多顯示的補全候選數量。
+const maxTagSuggestions = 5
+
+// tagIllegalChars 是標籤中不可使用的字元，避免破壞 front matter 的 tags 列表。
+const tagIllegalChars = "[]{}:\"'#"
+
+// newFormInput 建立表單中的單行輸入欄位，各語系的標題與標籤提示寬度相同以對齊輸入內容。
+// AI 心智註解: textinput 設定寬度後以顯示寬度切割 placeholder 的 rune，中文會產生 NUL 字元，因此不使用 placeholder，改在說明列提示。
+func newFormInput(prompt string) textinput.Model {
+	ti := textinput.New()
+	ti.Prompt = prompt
+	return ti
+}
+
+// parseTags 解析標籤欄位：以逗號分隔，去除前後空白與開頭的 #，略過空白與重複（不分大小寫）的標籤。
+func parseTags(value string) []string {
+	var tags []string
+	for _, part := range strings.Split(value, ",") {
+		tag := strings.TrimLeft(strings.TrimSpace(part), "#")
+		if tag == "" || slices.ContainsFunc(tags, func(t string) bool { return strings.EqualFold(t, tag) }) {
+			continue
+		}
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
+// validateTags 檢查標籤欄位中的每個標籤是否可以寫入 front matter。
+func validateTags(value string) error {
+	for _, tag := range parseTags(value) {
+		if strings.ContainsAny(tag, tagIllegalChars) {
+			return errors.New(i18n.T("form.invalid_tag", tagIllegalChars, tag))
+		}
+	}
+	return nil
+}
+
+// knownTags 返回已載入筆記中的所有標籤，依使用次數由多到少排序，次數相同時依名稱排序。
+func (m model) knownTags() []string {
+	counts := map[string]int{}
+	var tags []string
+	for _, n := range m.notes {
+		for _, tag := range n.Tags {
+			if counts[tag] == 0 {
+				tags = append(tags, tag)
+			}
+			counts[tag]++
+		}
+	}
+	slices.SortFunc(tags, func(a, b string) int {
+		if counts[a] != counts[b] {
+			return counts[b] - counts[a]
+		}
+		return strings.Compare(a, b)
+	})
+	return tags
+}
+
+// suggestTags 依標籤欄位最後一個（正在輸入的）標籤，返回以其開頭的既有標籤。
+// 已經輸入過的標籤與完全相同的標籤不列入候選。
+func (m model) suggestTags() []string {
+	value := m.tagsInput.Value()
+	done, last := "", value
+	if i := strings.LastIndex(value, ","); i >= 0 {
+		done, last = value[:i], value[i+1:]
+	}
+	prefix := strings.TrimLeft(strings.TrimSpace(last), "#")
+	if prefix == "" {
+		return nil
+	}
+	entered := parseTags(done)
+	var suggestions []string
+	for _, tag := range m.knownTags() {
+		if len(suggestions) == maxTagSuggestions {
+			break
+		}
+		// AI 心智註解: 不分大小寫比對前綴，補全時使用既有標籤的寫法，避免同一標籤出現多種大小寫。
+		if strings.EqualFold(tag, prefix) || !strings.HasPrefix(strings.ToLower(tag), strings.ToLower(prefix)) {
+			continue
+		}
+		if slices.ContainsFunc(entered, func(t string) bool { return strings.EqualFold(t, tag) }) {
+			continue
+		}
+		suggestions = append(suggestions, tag)
+	}
+	return suggestions
+}
+
+// acceptSuggestion 以選中的候選取代正在輸入的標籤，並加上逗號以便繼續輸入下一個標籤。
+func (m *model) acceptSuggestion() {
+	value := m.tagsInput.Value()
+	done := ""
+	if i := strings.LastIndex(value, ","); i >= 0 {
+		done = value[:i+1] + " "
+	}
+	m.tagsInput.SetValue(done + m.tagSuggestions[m.suggestionIndex] + ", ")
+	m.tagsInput.CursorEnd()
+	m.tagSuggestions = nil
+	m.suggestionIndex = 0
+}
+
+// focusField 將焦點移到指定欄位，只有取得焦點的欄位顯示游標。
+func (m *model) focusField(f formField) tea.Cmd {
+	m.formFocus = f
+	m.titleInput.Blur()
+	m.tagsInput.Blur()
+	m.inputArea.Blur()
+	m.titleInput.PromptStyle = m.styles.blurredPrompt
+	m.tagsInput.PromptStyle = m.styles.blurredPrompt
+	m.tagSuggestions = nil
+	switch f {
+	case fieldTitle:
+		m.titleInput.PromptStyle = m.styles.focusedPrompt
+		return m.titleInput.Focus()
+	case fieldTags:
+		m.tagsInput.PromptStyle = m.styles.focusedPrompt
+		m.tagSuggestions = m.suggestTags()
+		m.suggestionIndex = 0
+		return m.tagsInput.Focus()
+	}
+	m.inputArea.Focus()
+	return nil
+}
+
+// resetForm 清空表單的所有欄位並將焦點移回標題。
+func (m *model) resetForm() tea.Cmd {
+	m.titleInput.Reset()
+	m.tagsInput.Reset()
+	m.inputArea = NewInputArea()
+	m.inputArea.SetStyles(m.styles.focusedPrompt, m.styles.inputCursor)
+	m.formTried = false
+	return m.focusField(fieldTitle)
+}
+
+// fillForm 以草稿填入表單；已有標題時焦點放在內容，否則放在標題。
+func (m *model) fillForm(d draft.Draft) tea.Cmd {
+	m.resetForm()
+	m.titleInput.SetValue(d.Title)
+	m.tagsInput.SetValue(d.Tags)
+	m.inputArea.SetText(d.Body)
+	if d.Title != "" {
+		return m.focusField(fieldBody)
+	}
+	return m.focusField(fieldTitle)
+}
+
+// formDraft 返回表單目前內容對應的草稿。
+func (m model) formDraft() draft.Draft {
+	return draft.Draft{ID: m.draftID, Title: m.titleInput.Value(), Tags: m.tagsInput.Value(), Body: m.inputArea.Text()}
+}
+
+// fieldError 返回欄位的驗證錯誤，沒有錯誤時返回空字串。
+// 非法字元隨輸入即時提示；空白的必填欄位在第一次送出後才提示，避免剛開啟表單就顯示錯誤。
+func (m model) fieldError(f formField) string {
+	switch f {
+	case fieldTitle:
+		title := strings.TrimSpace(m.titleInput.Value())
+		if err := storage.ValidateTitle(title); err != nil {
+			return i18n.Error(err)
+		}
+		if title == "" && m.formTried {
+			return i18n.T("form.title_required")
+		}
+	case fieldTags:
+		if err := validateTags(m.tagsInput.Value()); err != nil {
+			return err.Error()
+		}
+	case fieldBody:
+		if strings.TrimSpace(m.inputArea.Text()) == "" && m.formTried {
+			return i18n.T("storage.empty_content")
+		}
+	}
+	return ""
+}
+
+// submitForm 驗證表單並在背景儲存筆記；有欄位未通過驗證時將焦點移到第一個錯誤的欄位。
+func (m model) submitForm(body string) (model, tea.Cmd) {
+	m.formTried = true
+	invalid := fieldCount
+	for f := fieldTitle; f < fieldBody; f++ {
+		if m.fieldError(f) != "" {
+			invalid = f
+			break
+		}
+	}
+	// AI 心智註解: 內容以送出時帶來的文字為準，欄位下方的提示則依輸入區域的內容顯示。
+	if invalid == fieldCount && strings.TrimSpace(body) == "" {
+		invalid = fieldBody
+	}
+	if invalid != fieldCount {
+		cmd := m.focusField(invalid)
+		m.layout()
+		return m, cmd
+	}
+	// AI 心智註解: 保留使用者原始內容，不裁剪前後空白。
+	n := note.NewNote(strings.TrimSpace(m.titleInput.Value()), body, parseTags(m.tagsInput.Value()))
+	return m, m.saveNote(n, i18n.T("form.saved", n.Title, n.ID()), true)
+}
+
+// updateForm 處理建立視圖表單中的按鍵：切換焦點、標籤補全，其餘按鍵交給取得焦點的欄位。
+func (m model) updateForm(msg tea.KeyMsg) (model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.NextField):
+		return m, m.focusField((m.formFocus + 1) % fieldCount)
+	case key.Matches(msg, m.keys.PrevField):
+		return m, m.focusField((m.formFocus + fieldCount - 1) % fieldCount)
+	}
+	var cmd tea.Cmd
+	switch m.formFocus {
+	case fieldTitle, fieldTags:
+		if msg.Type == tea.KeyEnter {
+			return m, m.focusField(m.formFocus + 1)
+		}
+		if m.formFocus == fieldTags && len(m.tagSuggestions) > 0 {
+			// AI 心智註解: 與 shell 的自動建議相同，游標在結尾時按 → 接受候選，其餘位置仍是移動游標。
+			switch msg.Type {
+			case tea.KeyUp:
+				m.suggestionIndex = (m.suggestionIndex + len(m.tagSuggestions) - 1) % len(m.tagSuggestions)
+				return m, nil
+			case tea.KeyDown:
+				m.suggestionIndex = (m.suggestionIndex + 1) % len(m.tagSuggestions)
+				return m, nil
+			case tea.KeyRight:
+				if m.tagsInput.Position() == len([]rune(m.tagsInput.Value())) {
+					m.acceptSuggestion()
+					return m, nil
+				}
+			}
+		}
+		if m.formFocus == fieldTitle {
+			m.titleInput, cmd = m.titleInput.Update(msg)
+			return m, cmd
+		}
+		before := m.tagsInput.Value()
+		m.tagsInput, cmd = m.tagsInput.Update(msg)
+		if m.tagsInput.Value() != before {
+			m.tagSuggestions = m.suggestTags()
+			m.suggestionIndex = 0
+		}
+		return m, cmd
+	}
+	newIA, cmd := m.inputArea.Update(msg)
+	m.inputArea = newIA.(InputArea)
+	return m, cmd
+}
+
+// formHelpKeys 返回取得焦點的欄位中固定按鍵的說明。
+func (m model) formHelpKeys() []key.Binding {
+	switch m.formFocus {
+	case fieldTitle:
+		return []key.Binding{fieldAcceptKey()}
+	case fieldTags:
+		if len(m.tagSuggestions) > 0 {
+			return append([]key.Binding{fieldAcceptKey()}, suggestionKeys()...)
+		}
+		return []key.Binding{fieldAcceptKey(), tagSeparatorKey()}
+	}
+	return composerKeys()
+}
+
+// formView 渲染建立視圖底部的表單：標題、標籤（含補全候選）與內容，欄位下方顯示驗證錯誤。
+func (m model) formView() string {
+	indent := strings.Repeat(" ", lipgloss.Width(m.titleInput.Prompt))
+	lines := []string{m.titleInput.View()}
+	if err := m.fieldError(fieldTitle); err != "" {
+		lines = append(lines, indent+m.styles.fieldError.Render(err))
+	}
+	lines = append(lines, m.tagsInput.View())
+	if len(m.tagSuggestions) > 0 {
+		items := make([]string, len(m.tagSuggestions))
+		for i, tag := range m.tagSuggestions {
+			if i == m.suggestionIndex {
+				items[i] = m.styles.selectedSuggestion.Render(tag)
+			} else {
+				items[i] = m.styles.suggestion.Render(tag)
+			}
+		}
+		lines = append(lines, indent+strings.Join(items, " "))
+	}
+	if err := m.fieldError(fieldTags); err != "" {
+		lines = append(lines, indent+m.styles.fieldError.Render(err))
+	}
+	if err := m.fieldError(fieldBody); err != "" {
+		lines = append(lines, m.styles.fieldError.Render(err))
+	}
+	lines = append(lines, m.inputArea.View())
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,139 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/cursor"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+)
+
+// openForm 從列表按 n 開啟建立視圖，並固定表單欄位的游標。
+// AI 心智註解: 游標閃爍的指令會真的等待，固定游標讓 update 只執行其他背景指令。
+func openForm(t *testing.T, m model) model {
+	updatedModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	m = updatedModel.(model)
+	require.Equal(t, createView, m.currentView)
+	m.titleInput.Cursor.SetMode(cursor.CursorStatic)
+	m.tagsInput.Cursor.SetMode(cursor.CursorStatic)
+	return m
+}
+
+// fillFormFields 依序在標題、標籤與內容欄位輸入文字，內容中的換行以 Ctrl+J 輸入。
+func fillFormFields(m model, title, tags, body string) model {
+	m = pressKey(m, typeText(title))
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyTab})
+	m = pressKey(m, typeText(tags))
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyTab})
+	for i, line := range strings.Split(body, "\n") {
+		if i > 0 {
+			m = pressKey(m, tea.KeyMsg{Type: tea.KeyCtrlJ})
+		}
+		m = pressKey(m, typeText(line))
+	}
+	return m
+}
+
+func TestParseTags(t *testing.T) {
+	assert.Equal(t, []string{"work", "side project", "Go"}, parseTags(" #work, side project,,Go, WORK "))
+	assert.Empty(t, parseTags(" , "))
+	assert.NoError(t, validateTags("work, idea"))
+	assert.ErrorContains(t, validateTags("work, a:b"), "a:b")
+}
+
+// TestForm_SubmitWithTags 測試表單送出時以標題、標籤與內容建立筆記，並清空表單。
+func TestForm_SubmitWithTags(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	m := openForm(t, loadedModel())
+	assert.Equal(t, fieldTitle, m.formFocus)
+	m = fillFormFields(m, "週會", "work, #meeting", "第一點\n第二點")
+	m = update(m, tea.KeyMsg{Type: tea.KeyEnter})
+
+	require.Len(t, m.notes, 1)
+	n := m.notes[0]
+	assert.Equal(t, "週會", n.Title)
+	assert.Equal(t, []string{"work", "meeting"}, n.Tags)
+	assert.Equal(t, "第一點\n第二點", n.Content)
+	assert.Equal(t, "週會  #work #meeting\n第一點\n第二點", m.history[len(m.history)-2].text)
+
+	assert.Empty(t, m.titleInput.Value())
+	assert.Empty(t, m.tagsInput.Value())
+	assert.Empty(t, m.inputArea.Text())
+	assert.Equal(t, fieldTitle, m.formFocus)
+}
+
+// TestForm_FocusCycle 測試 Tab 與 Shift+Tab 循環切換焦點，標題欄位中的 Enter 移到下一欄。
+func TestForm_FocusCycle(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	m := openForm(t, loadedModel())
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, fieldTags, m.formFocus)
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyTab})
+	assert.Equal(t, fieldBody, m.formFocus)
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyTab})
+	assert.Equal(t, fieldTitle, m.formFocus)
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyShiftTab})
+	assert.Equal(t, fieldBody, m.formFocus)
+	assert.Contains(t, m.View(), "ctrl+j 換行")
+}
+
+// TestForm_InlineValidation 測試非法字元即時提示，空白的必填欄位在送出後提示，且未通過驗證時不儲存。
+func TestForm_InlineValidation(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	m := openForm(t, loadedModel())
+	assert.NotContains(t, m.View(), "筆記標題不能為空", "剛開啟表單時不應提示空白欄位")
+
+	m = pressKey(m, typeText("a/b"))
+	assert.Contains(t, m.View(), "標題包含非法字元")
+
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyTab})
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyTab})
+	m = update(m, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, fieldTitle, m.formFocus, "焦點應移到第一個錯誤的欄位")
+	assert.Contains(t, m.View(), "內容不可為空")
+	assert.Empty(t, m.notes)
+
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyCtrlU})
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyBackspace})
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyBackspace})
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyBackspace})
+	assert.Contains(t, m.View(), "筆記標題不能為空")
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyTab})
+	m = pressKey(m, typeText("a:b"))
+	assert.Contains(t, m.View(), "標籤包含非法字元")
+}
+
+// TestForm_TagAutocomplete 測試標籤欄位依既有標籤補全，常用的標籤排在前面，已輸入的標籤不再列出。
+func TestForm_TagAutocomplete(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	require.NoError(t, storage.SaveNote(note.NewNote("一", "內容", []string{"writing", "work"})))
+	require.NoError(t, storage.SaveNote(note.NewNote("二", "內容", []string{"work"})))
+
+	m := openForm(t, loadedModel())
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyTab})
+	m = pressKey(m, typeText("W"))
+	assert.Equal(t, []string{"work", "writing"}, m.tagSuggestions)
+	assert.Contains(t, m.View(), "→ 補全")
+
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyDown})
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyRight})
+	assert.Equal(t, "writing, ", m.tagsInput.Value())
+	assert.Empty(t, m.tagSuggestions)
+
+	m = pressKey(m, typeText("w"))
+	assert.Equal(t, []string{"work"}, m.tagSuggestions, "已輸入的標籤不應再列出")
+	m = pressKey(m, typeText("ork"))
+	assert.Empty(t, m.tagSuggestions, "完全相同的標籤不需補全")
+}
@@ -0,0 +1,110 @@
+// Package tui 提供了終端使用者介面 (TUI) 的實現。
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+)
+
+// role 表示歷史對話區域中一則訊息的來源。
+type role int
+
+const (
+	roleUser   role = iota // 使用者輸入的筆記條目。
+	roleSystem             // 系統回應，例如儲存成功。
+	roleError              // 系統回應的錯誤訊息。
+)
+
+// 預設的終端尺寸，在收到 tea.WindowSizeMsg 之前使用。
+const (
+	defaultWidth  = 80
+	defaultHeight = 24
+)
+
+// historyEntry 是歷史對話區域中的單一訊息。
+type historyEntry struct {
+	role role   // 訊息來源。
+	text string // 訊息內容，可能包含多行。
+}
+
+// noteEntry 將筆記轉為使用者訊息，第一行為標題與標籤，其餘為內容。
+func noteEntry(n *note.Note) historyEntry {
+	text := n.Title
+	if len(n.Tags) > 0 {
+		text += "  #" + strings.Join(n.Tags, " #")
+	}
+	if body := strings.TrimRight(n.Content, "\n"); body != "" {
+		text += "\n" + body
+	}
+	return historyEntry{role: roleUser, text: text}
+}
+
+// renderHistory 以 roleStyles 中各來源的樣式渲染所有訊息，並依 width 自動換行。
+func renderHistory(entries []historyEntry, width int, roleStyles map[role]lipgloss.Style) string {
+	blocks := make([]string, 0, len(entries))
+	for _, e := range entries {
+		// AI 心智註解: 以 Width 讓 lipgloss 依終端寬度斷行，確保寬字元也不會超出 viewport。
+		blocks = append(blocks, roleStyles[e.role].Width(width).Render(e.text))
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+// appendHistory 新增訊息並捲動到最新一則。
+func (m *model) appendHistory(r role, text string) {
+	m.history = append(m.history, historyEntry{role: r, text: text})
+	m.historyView.SetContent(renderHistory(m.history, m.historyView.Width, m.styles.history))
+	m.historyView.GotoBottom()
+}
+
+// layout 依終端尺寸與表單高度調整歷史對話區域，讓表單固定在畫面底部。
+func (m *model) layout() {
+	width, height := m.width, m.height
+	if width <= 0 {
+		width = defaultWidth
+	}
+	if height <= 0 {
+		height = defaultHeight
+	}
+	// AI 心智註解: 輸入區域隨內容長高，但最多佔終端高度的三分之一，超過時在內部捲動。
+	m.inputArea.SetWidth(width)
+	m.inputArea.SetMaxHeight(max(height/3, 1))
+	// AI 心智註解: 保留一欄給 textinput 在結尾顯示的游標。
+	m.titleInput.Width = max(width-lipgloss.Width(m.titleInput.Prompt)-1, 1)
+	m.tagsInput.Width = max(width-lipgloss.Width(m.tagsInput.Prompt)-1, 1)
+	// AI 心智註解: 扣除標題列、表單與底部說明列，剩下的高度全部給歷史區域。
+	h := height - lipgloss.Height(i18n.T("form.header")) - lipgloss.Height(m.formView()) - lipgloss.Height(m.createFooter())
+	if h < 1 {
+		h = 1
+	}
+	atBottom := m.historyView.AtBottom()
+	widthChanged := m.historyView.Width != width
+	m.historyView.Width = width
+	m.historyView.Height = h
+	if widthChanged {
+		m.historyView.SetContent(renderHistory(m.history, width, m.styles.history))
+	}
+	if atBottom {
+		m.historyView.GotoBottom()
+	}
+}
+
+// createFooter 返回建立視圖底部的狀態列與說明列；有待處理的大量貼上時改為顯示詢問。
+func (m model) createFooter() string {
+	if m.pendingPaste != "" {
+		return m.pastePrompt()
+	}
+	return m.footerView()
+}
+
+// createViewString 渲染建立視圖：歷史對話區域在上，表單固定在底部。
+func (m model) createViewString() string {
+	return lipgloss.JoinVertical(lipgloss.Left,
+		m.styles.header.Render(i18n.T("form.header"))+m.busyIndicator(),
+		m.historyView.View(),
+		m.formView(),
+		m.createFooter(),
+	)
+}
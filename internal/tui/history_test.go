@@ -0,0 +1,73 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHistoryStylesDifferByRole 確認使用者訊息與系統回應使用不同顏色。
+func TestHistoryStylesDifferByRole(t *testing.T) {
+	history := newStyles(builtinThemes[themeDark], true).history
+	assert.NotEqual(t, history[roleUser].GetForeground(), history[roleSystem].GetForeground())
+	assert.NotEqual(t, history[roleSystem].GetForeground(), history[roleError].GetForeground())
+}
+
+// TestCreateView_LoadsHistoryAndPinsInput 測試建立視圖載入既有筆記，且輸入區域固定在底部。
+func TestCreateView_LoadsHistoryAndPinsInput(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	require.NoError(t, writeTestNote("Existing", "Old body"))
+
+	m := loadedModel()
+	updatedModel, _ := m.Update(tea.WindowSizeMsg{Width: 40, Height: 12})
+	m = updatedModel.(model)
+	updatedModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	m = updatedModel.(model)
+
+	require.Len(t, m.history, 1)
+	assert.Equal(t, "Existing\nOld body", m.history[0].text)
+
+	view := m.View()
+	assert.Equal(t, 12, lipgloss.Height(view))
+	lines := strings.Split(view, "\n")
+	assert.Equal(t, m.helpView(), strings.TrimRight(lines[len(lines)-1], " "))
+	assert.Contains(t, lines[len(lines)-2], m.inputArea.placeholder)
+
+	// AI 心智註解: 多行輸入會讓內容欄位長高，總高度仍須維持與終端一致。
+	updatedModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyShiftTab})
+	m = updatedModel.(model)
+	updatedModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlJ})
+	m = updatedModel.(model)
+	assert.Equal(t, 12, lipgloss.Height(m.View()))
+}
+
+// TestCreateView_SubmitAppendsConversation 測試送出後依序加入使用者條目與系統回應，並留在建立視圖。
+func TestCreateView_SubmitAppendsConversation(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	m := openForm(t, loadedModel())
+	m = pressKey(m, typeText("標題"))
+	m = update(m, SubmitMsg{Text: "內容"})
+
+	require.Len(t, m.history, 2)
+	assert.Equal(t, roleUser, m.history[0].role)
+	assert.Equal(t, "標題\n內容", m.history[0].text)
+	assert.Equal(t, roleSystem, m.history[1].role)
+	assert.Contains(t, m.history[1].text, "已儲存筆記「標題」")
+	assert.Equal(t, createView, m.currentView)
+	assert.Equal(t, []string{"標題"}, noteTitles(m.notes))
+	assert.Empty(t, m.titleInput.Value())
+
+	// AI 心智註解: 標題空白時在欄位下方提示，不加入對話也不記錄錯誤。
+	m = update(m, SubmitMsg{Text: "body"})
+	assert.Len(t, m.history, 2)
+	assert.Equal(t, "筆記標題不能為空", m.fieldError(fieldTitle))
+	assert.Empty(t, m.errorLog)
+}
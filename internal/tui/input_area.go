@@ -2,16 +2,45 @@
 package tui
 
 import (
+	"strings"
+	"unicode"
+
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+)
+
+// 輸入區域每一列的前綴：第一列顯示提示符號，其餘列以空白對齊。
+const (
+	promptPrefix       = "> "
+	continuationPrefix = "  "
 )
 
+// tabWidth 是 Tab 字元在輸入區域中顯示的寬度。
+const tabWidth = 4
+
 // InputArea 結構體代表輸入區域組件，處理用戶輸入。
 type InputArea struct {
 	runes       []rune         // AI 心智註解: 以 rune 切片儲存輸入，避免多位元字元被破壞。
 	cursor      int            // AI 心智註解: 記錄目前游標所在的 rune index。
 	placeholder string         // 提示文字。
-	styles      lipgloss.Style // 樣式設定。
+	promptStyle lipgloss.Style // 取得焦點時第一列提示符號的樣式。
+	cursorStyle lipgloss.Style // 游標的樣式。
+	width       int            // 可用的終端寬度（含前綴），0 表示不自動換行。
+	maxHeight   int            // 最多顯示的列數，超過時內部捲動，0 表示不限制。
+	offset      int            // 內部捲動時第一個顯示的列。
+	undo, redo  []snapshot     // 復原與重做堆疊。
+	lastEdit    editKind       // 上一個按鍵的編輯種類，用於合併連續輸入與連續刪除。
+	killRing    []string       // Ctrl+K/U/W 刪除的文字，最新的在最後。
+	yankIndex   int            // 最近一次貼上的 kill ring 項目。
+	yankStart   int            // 最近一次貼上文字的起始位置。
+	focus       bool           // 是否取得焦點，失去焦點時不顯示游標。
+}
+
+// row 是輸入內容經過換行與自動換行後的一個顯示列，以 rune index 表示範圍（不含換行字元）。
+type row struct {
+	start, end int
 }
 
 // NewInputArea 函數創建並返回一個新的 InputArea 實例。
@@ -19,11 +48,40 @@ func NewInputArea() InputArea {
 	return InputArea{
 		runes:       []rune{},
 		cursor:      0,
-		placeholder: "在這裡輸入…Enter 送出 / Ctrl+J 換行",
-		styles:      lipgloss.NewStyle().Foreground(lipgloss.Color("15")).Background(lipgloss.Color("235")),
+		placeholder: i18n.T("form.body_placeholder"),
+		cursorStyle: lipgloss.NewStyle().Reverse(true),
+		focus:       true,
 	}
 }
 
+// SetStyles 設定取得焦點時提示符號與游標的樣式，由 TUI 的主題決定。
+func (ia *InputArea) SetStyles(prompt, cursor lipgloss.Style) {
+	ia.promptStyle = prompt
+	ia.cursorStyle = cursor
+}
+
+// Focus 讓輸入區域取得焦點並顯示游標。
+func (ia *InputArea) Focus() {
+	ia.focus = true
+}
+
+// Blur 讓輸入區域失去焦點並隱藏游標。
+func (ia *InputArea) Blur() {
+	ia.focus = false
+}
+
+// SetWidth 設定輸入區域可用的寬度，內容會依此自動換行。
+func (ia *InputArea) SetWidth(width int) {
+	ia.width = width
+	ia.scrollToCursor()
+}
+
+// SetMaxHeight 設定輸入區域最多顯示的列數，超過時在內部捲動。
+func (ia *InputArea) SetMaxHeight(height int) {
+	ia.maxHeight = height
+	ia.scrollToCursor()
+}
+
 // Init 函數在 InputArea 初始化時被呼叫。
 func (ia InputArea) Init() tea.Cmd {
 	return nil
@@ -33,45 +91,119 @@ func (ia InputArea) Init() tea.Cmd {
 func (ia InputArea) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		switch msg.Type {
-		case tea.KeyRunes:
-			// AI 心智註解: 插入多個 rune 時保持原順序，確保多位元字元完整。
-			ia.runes = insertRunes(ia.runes, ia.cursor, msg.Runes)
-			ia.cursor += len(msg.Runes)
-		case tea.KeyBackspace:
+		// AI 心智註解: 先重設編輯種類，只有實際修改內容的按鍵才會設定新的種類。
+		prev := ia.lastEdit
+		ia.lastEdit = editNone
+		switch msg.String() {
+		case "enter":
+			// 提交輸入
+			return ia, func() tea.Msg { return SubmitMsg{Text: string(ia.runes)} }
+		case "ctrl+j":
+			// AI 心智註解: Ctrl+J 也視為插入換行 rune。
+			ia.record(prev, editInsert)
+			ia.insert([]rune{'\n'})
+		case "backspace":
 			if ia.cursor > 0 {
 				// AI 心智註解: Backspace 按 rune 刪除，避免刪半個字元。
-				ia.runes = append(ia.runes[:ia.cursor-1], ia.runes[ia.cursor:]...)
-				ia.cursor--
+				ia.record(prev, editDelete)
+				ia.deleteRange(ia.cursor-1, ia.cursor)
 			}
-		case tea.KeyLeft:
+		case "delete", "ctrl+d":
+			if ia.cursor < len(ia.runes) {
+				ia.record(prev, editDelete)
+				ia.deleteRange(ia.cursor, ia.cursor+1)
+			}
+		case "ctrl+w", "alt+backspace":
+			ia.kill(prev, ia.wordLeft(), ia.cursor, true)
+		case "ctrl+u":
+			ia.kill(prev, ia.lineStart(), ia.cursor, true)
+		case "ctrl+k":
+			ia.killToLineEnd(prev)
+		case "ctrl+y":
+			ia.yank(prev)
+		case "alt+y":
+			ia.yankPop(prev)
+		case "ctrl+z", "ctrl+_":
+			ia.undoEdit()
+		case "ctrl+r":
+			ia.redoEdit()
+		case "left", "ctrl+b":
 			if ia.cursor > 0 {
 				ia.cursor--
 			}
-		case tea.KeyRight:
+		case "right", "ctrl+f":
 			if ia.cursor < len(ia.runes) {
 				ia.cursor++
 			}
-		case tea.KeyEnter:
-			// 提交輸入
-			return ia, func() tea.Msg { return SubmitMsg{Text: string(ia.runes)} }
-		case tea.KeyCtrlJ:
-			// AI 心智註解: Ctrl+J 也視為插入換行 rune。
-			ia.runes = insertRunes(ia.runes, ia.cursor, []rune{'\n'})
-			ia.cursor++
+		case "alt+b", "alt+left":
+			ia.cursor = ia.wordLeft()
+		case "alt+f", "alt+right":
+			ia.cursor = ia.wordRight()
+		case "home", "ctrl+a":
+			ia.cursor = ia.lineStart()
+		case "end", "ctrl+e":
+			ia.cursor = ia.lineEnd()
+		case "up":
+			ia.moveRow(-1)
+		case "down":
+			ia.moveRow(1)
+		default:
+			if msg.Paste {
+				// AI 心智註解: 括號貼上的內容原樣插入，換行與 Tab 不會觸發送出或其他快捷鍵。
+				runes := normalizePaste(msg.Runes)
+				if isLargePaste(runes) {
+					return ia, func() tea.Msg { return largePasteMsg{text: string(runes)} }
+				}
+				ia.insertPaste(runes)
+			} else if msg.Type == tea.KeyRunes && !msg.Alt {
+				// AI 心智註解: 插入多個 rune 時保持原順序，確保多位元字元完整。
+				ia.record(prev, editInsert)
+				ia.insert(msg.Runes)
+			}
 		}
+		ia.scrollToCursor()
 	}
 	return ia, nil
 }
 
 // View 函數渲染 InputArea 的視覺表示。
 func (ia InputArea) View() string {
+	cursorStyle, prompt := ia.cursorStyle, ia.promptStyle.Render(promptPrefix)
+	if !ia.focus {
+		// AI 心智註解: 失去焦點時游標與提示符號不套用樣式，文字寬度與取得焦點時相同。
+		cursorStyle, prompt = lipgloss.NewStyle(), promptPrefix
+	}
 	if len(ia.runes) == 0 {
-		return "> " + ia.placeholder
+		placeholder := []rune(ia.placeholder)
+		return prompt + cursorStyle.Render(string(placeholder[:1])) + string(placeholder[1:])
+	}
+	rows := ia.rows()
+	cur := ia.cursorRow(rows)
+	last := len(rows)
+	if ia.maxHeight > 0 && ia.offset+ia.maxHeight < last {
+		last = ia.offset + ia.maxHeight
+	}
+	lines := make([]string, 0, last-ia.offset)
+	for i := ia.offset; i < last; i++ {
+		prefix := continuationPrefix
+		if i == 0 {
+			prefix = prompt
+		}
+		r := rows[i]
+		if i != cur {
+			lines = append(lines, prefix+displayString(ia.runes[r.start:r.end]))
+			continue
+		}
+		// AI 心智註解: 游標以反白的字元呈現，位於列尾時反白一個空白，不改變文字寬度。
+		at := " "
+		after := ""
+		if ia.cursor < r.end {
+			at = displayString(ia.runes[ia.cursor : ia.cursor+1])
+			after = displayString(ia.runes[ia.cursor+1 : r.end])
+		}
+		lines = append(lines, prefix+displayString(ia.runes[r.start:ia.cursor])+cursorStyle.Render(at)+after)
 	}
-	before := string(ia.runes[:ia.cursor])
-	after := string(ia.runes[ia.cursor:])
-	return "> " + before + "|" + after
+	return strings.Join(lines, "\n")
 }
 
 // Text 取得當前輸入內容的字串表示，供測試使用。
@@ -80,6 +212,213 @@ func (ia InputArea) Text() string {
 	return string(ia.runes)
 }
 
+// SetText 以指定內容取代輸入區域並將游標移到結尾，例如還原草稿時使用。
+func (ia *InputArea) SetText(text string) {
+	ia.runes = []rune(text)
+	ia.cursor = len(ia.runes)
+	ia.undo, ia.redo = nil, nil
+	ia.lastEdit = editNone
+	ia.scrollToCursor()
+}
+
+// insert 在游標位置插入 rune 並將游標移到插入內容之後。
+func (ia *InputArea) insert(rs []rune) {
+	ia.runes = insertRunes(ia.runes, ia.cursor, rs)
+	ia.cursor += len(rs)
+}
+
+// insertPaste 將貼上內容原樣插入游標位置，作為獨立的復原步驟。
+func (ia *InputArea) insertPaste(rs []rune) {
+	ia.record(ia.lastEdit, editPaste)
+	ia.insert(rs)
+	ia.scrollToCursor()
+}
+
+// deleteRange 刪除 [from, to) 範圍的 rune，並將游標移到 from。
+func (ia *InputArea) deleteRange(from, to int) {
+	if from >= to {
+		return
+	}
+	ia.runes = append(ia.runes[:from:from], ia.runes[to:]...)
+	ia.cursor = from
+}
+
+// isWordRune 判斷 rune 是否屬於單字，用於依單字移動與刪除。
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// wordLeft 返回游標往前一個單字開頭的位置。
+func (ia InputArea) wordLeft() int {
+	i := ia.cursor
+	for i > 0 && !isWordRune(ia.runes[i-1]) {
+		i--
+	}
+	for i > 0 && isWordRune(ia.runes[i-1]) {
+		i--
+	}
+	return i
+}
+
+// wordRight 返回游標往後一個單字結尾的位置。
+func (ia InputArea) wordRight() int {
+	i := ia.cursor
+	for i < len(ia.runes) && !isWordRune(ia.runes[i]) {
+		i++
+	}
+	for i < len(ia.runes) && isWordRune(ia.runes[i]) {
+		i++
+	}
+	return i
+}
+
+// lineStart 返回游標所在邏輯行的開頭位置。
+func (ia InputArea) lineStart() int {
+	i := ia.cursor
+	for i > 0 && ia.runes[i-1] != '\n' {
+		i--
+	}
+	return i
+}
+
+// lineEnd 返回游標所在邏輯行的結尾位置（換行字元之前）。
+func (ia InputArea) lineEnd() int {
+	i := ia.cursor
+	for i < len(ia.runes) && ia.runes[i] != '\n' {
+		i++
+	}
+	return i
+}
+
+// wrapWidth 返回每列可容納的文字寬度；保留一格給列尾的游標。
+func (ia InputArea) wrapWidth() int {
+	if ia.width <= 0 {
+		return 0
+	}
+	return max(ia.width-runewidth.StringWidth(promptPrefix)-1, 1)
+}
+
+// rows 依換行字元與可用寬度將內容切成顯示列。
+// AI 心智註解: 以 runewidth 計算寬度，中日韓全形字佔兩格，放不下時整個字移到下一列。
+func (ia InputArea) rows() []row {
+	limit := ia.wrapWidth()
+	var rows []row
+	start, w := 0, 0
+	for i, r := range ia.runes {
+		if r == '\n' {
+			rows = append(rows, row{start, i})
+			start, w = i+1, 0
+			continue
+		}
+		rw := runeWidth(r)
+		if limit > 0 && w+rw > limit && i > start {
+			rows = append(rows, row{start, i})
+			start, w = i, 0
+		}
+		w += rw
+	}
+	return append(rows, row{start, len(ia.runes)})
+}
+
+// cursorRow 返回游標所在的顯示列。
+// 游標位於自動換行的列尾時，視為下一列的開頭。
+func (ia InputArea) cursorRow(rows []row) int {
+	for i, r := range rows {
+		if ia.cursor < r.start || ia.cursor > r.end {
+			continue
+		}
+		if ia.cursor == r.end && i+1 < len(rows) && rows[i+1].start == r.end {
+			continue
+		}
+		return i
+	}
+	return len(rows) - 1
+}
+
+// moveRow 將游標上下移動 delta 個顯示列，盡量維持相同的顯示欄位。
+func (ia *InputArea) moveRow(delta int) {
+	rows := ia.rows()
+	cur := ia.cursorRow(rows)
+	target := cur + delta
+	if target < 0 || target >= len(rows) {
+		return
+	}
+	col := runewidth.StringWidth(displayString(ia.runes[rows[cur].start:ia.cursor]))
+	r := rows[target]
+	i, w := r.start, 0
+	for i < r.end {
+		rw := runeWidth(ia.runes[i])
+		if w+rw > col {
+			break
+		}
+		w += rw
+		i++
+	}
+	// AI 心智註解: 停在自動換行列的列尾會被視為下一列，因此退回該列最後一個字元。
+	if i == r.end && target+1 < len(rows) && rows[target+1].start == r.end && i > r.start {
+		i--
+	}
+	ia.cursor = i
+}
+
+// MoveCursorTo 將游標移到畫面上第 row 列、第 col 欄的位置，例如滑鼠點擊時使用。
+// row 從目前顯示的第一列起算，col 含列首的提示符號；超出內容時移到最接近的位置。
+func (ia *InputArea) MoveCursorTo(row, col int) {
+	rows := ia.rows()
+	target := max(min(ia.offset+row, len(rows)-1), 0)
+	col -= runewidth.StringWidth(promptPrefix)
+	r := rows[target]
+	i, w := r.start, 0
+	for i < r.end {
+		rw := runeWidth(ia.runes[i])
+		// AI 心智註解: 點在全形字或 Tab 的後半時，游標放在該字元之後。
+		if w+rw > col {
+			if col-w >= (rw+1)/2 {
+				i++
+			}
+			break
+		}
+		w += rw
+		i++
+	}
+	if i == r.end && target+1 < len(rows) && rows[target+1].start == r.end && i > r.start {
+		i--
+	}
+	ia.cursor = i
+	ia.lastEdit = editNone
+	ia.scrollToCursor()
+}
+
+// runeWidth 返回 rune 在輸入區域中的顯示寬度，Tab 以固定寬度顯示。
+func runeWidth(r rune) int {
+	if r == '\t' {
+		return tabWidth
+	}
+	return runewidth.RuneWidth(r)
+}
+
+// displayString 將 rune 轉為顯示用字串，Tab 展開為空白，與 runeWidth 的寬度一致。
+func displayString(rs []rune) string {
+	return strings.ReplaceAll(string(rs), "\t", strings.Repeat(" ", tabWidth))
+}
+
+// scrollToCursor 調整內部捲動位置，確保游標所在的列可見。
+func (ia *InputArea) scrollToCursor() {
+	if ia.maxHeight <= 0 {
+		ia.offset = 0
+		return
+	}
+	rows := ia.rows()
+	cur := ia.cursorRow(rows)
+	if cur < ia.offset {
+		ia.offset = cur
+	}
+	if cur >= ia.offset+ia.maxHeight {
+		ia.offset = cur - ia.maxHeight + 1
+	}
+	ia.offset = max(min(ia.offset, len(rows)-ia.maxHeight), 0)
+}
+
 // insertRunes 將新 rune 插入到指定位置，回傳新的 rune 切片。
 func insertRunes(base []rune, idx int, toInsert []rune) []rune {
 	// AI 心智註解: 透過重新配置切片確保插入操作不污染原切片共享的底層陣列。
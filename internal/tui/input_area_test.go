@@ -1,10 +1,13 @@
 package tui
 
 import (
+	"strings"
 	"testing"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mattn/go-runewidth"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestInputAreaHandlesMultibyteRunes(t *testing.T) {
@@ -27,3 +30,103 @@ func TestInputAreaHandlesMultibyteRunes(t *testing.T) {
 	ia = model.(InputArea)
 	assert.Equal(t, "漢", ia.Text())
 }
+
+// pressKeys 依序送出按鍵訊息並返回更新後的 InputArea。
+func pressKeys(ia InputArea, keys ...tea.KeyMsg) InputArea {
+	for _, k := range keys {
+		model, _ := ia.Update(k)
+		ia = model.(InputArea)
+	}
+	return ia
+}
+
+// typeText 模擬輸入一段文字。
+func typeText(text string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(text)}
+}
+
+func TestInputAreaLineAndWordMovement(t *testing.T) {
+	// AI 心智註解: Home/End 以邏輯行為單位，Alt+B/F 以單字為單位移動。
+	ia := pressKeys(NewInputArea(), typeText("hello 世界"), tea.KeyMsg{Type: tea.KeyCtrlJ}, typeText("second line"))
+	assert.Equal(t, len([]rune("hello 世界\nsecond line")), ia.cursor)
+
+	ia = pressKeys(ia, tea.KeyMsg{Type: tea.KeyHome})
+	assert.Equal(t, len([]rune("hello 世界\n")), ia.cursor)
+
+	ia = pressKeys(ia, tea.KeyMsg{Type: tea.KeyEnd})
+	assert.Equal(t, len(ia.runes), ia.cursor)
+
+	ia = pressKeys(ia, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}, Alt: true})
+	assert.Equal(t, len([]rune("hello 世界\nsecond ")), ia.cursor)
+
+	ia = pressKeys(ia, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}, Alt: true}, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}, Alt: true})
+	assert.Equal(t, len([]rune("hello ")), ia.cursor)
+
+	ia = pressKeys(ia, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}, Alt: true})
+	assert.Equal(t, len([]rune("hello 世界")), ia.cursor)
+	assert.Equal(t, "hello 世界\nsecond line", ia.Text(), "Alt 組合鍵不應插入文字")
+}
+
+func TestInputAreaWordAndForwardDeletion(t *testing.T) {
+	// AI 心智註解: Ctrl+W 刪除游標前的單字，Delete 刪除游標所在的 rune。
+	ia := pressKeys(NewInputArea(), typeText("筆記 內容 draft"))
+	ia = pressKeys(ia, tea.KeyMsg{Type: tea.KeyCtrlW})
+	assert.Equal(t, "筆記 內容 ", ia.Text())
+
+	ia = pressKeys(ia, tea.KeyMsg{Type: tea.KeyHome}, tea.KeyMsg{Type: tea.KeyDelete})
+	assert.Equal(t, "記 內容 ", ia.Text())
+	assert.Equal(t, 0, ia.cursor)
+}
+
+func TestInputAreaSoftWrapUsesDisplayWidth(t *testing.T) {
+	// AI 心智註解: 寬度 10 扣除提示符號與游標保留格後每列 7 格，全形字佔 2 格。
+	ia := NewInputArea()
+	ia.SetWidth(10)
+	ia = pressKeys(ia, typeText("中文字測試"))
+
+	assert.Equal(t, []row{{0, 3}, {3, 5}}, ia.rows())
+	lines := strings.Split(ia.View(), "\n")
+	require.Len(t, lines, 2)
+	assert.Equal(t, "> 中文字", lines[0])
+	for _, line := range lines {
+		assert.LessOrEqual(t, runewidth.StringWidth(line), 10)
+	}
+
+	// AI 心智註解: 上下移動跨越自動換行的列，盡量維持相同的顯示欄位。
+	ia = pressKeys(ia, tea.KeyMsg{Type: tea.KeyUp})
+	assert.Equal(t, 2, ia.cursor)
+	ia = pressKeys(ia, tea.KeyMsg{Type: tea.KeyHome}, tea.KeyMsg{Type: tea.KeyRight}, tea.KeyMsg{Type: tea.KeyDown})
+	assert.Equal(t, 4, ia.cursor)
+}
+
+func TestInputAreaMaxHeightScrolls(t *testing.T) {
+	// AI 心智註解: 內容超過最大高度時只顯示游標附近的列。
+	ia := NewInputArea()
+	ia.SetMaxHeight(2)
+	ia = pressKeys(ia, typeText("一"), tea.KeyMsg{Type: tea.KeyCtrlJ}, typeText("二"), tea.KeyMsg{Type: tea.KeyCtrlJ}, typeText("三"))
+
+	lines := strings.Split(ia.View(), "\n")
+	assert.Equal(t, []string{"  二", "  三 "}, lines)
+
+	ia = pressKeys(ia, tea.KeyMsg{Type: tea.KeyUp}, tea.KeyMsg{Type: tea.KeyUp})
+	lines = strings.Split(ia.View(), "\n")
+	assert.Equal(t, "> 一 ", lines[0])
+	assert.Len(t, lines, 2)
+}
+
+// TestInputAreaMoveCursorTo 測試依點擊的列與顯示欄位移動游標，全形字依點在前半或後半決定游標在字元前後。
+func TestInputAreaMoveCursorTo(t *testing.T) {
+	ia := NewInputArea()
+	ia.SetText("ab\n漢字c")
+
+	ia.MoveCursorTo(0, 3)
+	assert.Equal(t, 1, ia.cursor)
+	ia.MoveCursorTo(1, 2)
+	assert.Equal(t, 3, ia.cursor, "點在「漢」的前半")
+	ia.MoveCursorTo(1, 3)
+	assert.Equal(t, 4, ia.cursor, "點在「漢」的後半")
+	ia.MoveCursorTo(1, 40)
+	assert.Equal(t, 6, ia.cursor, "超出列尾時移到列尾")
+	ia.MoveCursorTo(5, 0)
+	assert.Equal(t, 3, ia.cursor, "超出內容時移到最後一列")
+}
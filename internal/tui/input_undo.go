@@ -0,0 +1,118 @@
+// Package tui 提供了終端使用者介面 (TUI) 的實現。
+package tui
+
+// editKind 表示輸入區域的編輯種類，用於合併連續的編輯為單一復原步驟。
+type editKind int
+
+const (
+	editNone   editKind = iota // 非編輯操作，例如移動游標。
+	editInsert                 // 輸入文字。
+	editDelete                 // Backspace 或 Delete 刪除字元。
+	editKill                   // 刪除並放入 kill ring（Ctrl+K/U/W）。
+	editYank                   // 從 kill ring 貼上（Ctrl+Y/Alt+Y）。
+	editPaste                  // 終端的括號貼上，每次貼上都是獨立的復原步驟。
+)
+
+// 復原堆疊與 kill ring 的容量上限。
+const (
+	maxUndoSteps = 100
+	killRingSize = 16
+)
+
+// snapshot 記錄輸入內容與游標位置，用於復原與重做。
+// AI 心智註解: insertRunes 與 deleteRange 都會重新配置切片，因此快照可直接保存切片而不必複製。
+type snapshot struct {
+	runes  []rune
+	cursor int
+}
+
+// record 在編輯前保存快照並清除重做堆疊。
+// 與上一個操作同為輸入或同為刪除字元時不另外保存，讓連續的輸入合併為一個復原步驟。
+func (ia *InputArea) record(prev, kind editKind) {
+	ia.lastEdit = kind
+	ia.redo = nil
+	if kind == prev && (kind == editInsert || kind == editDelete) {
+		return
+	}
+	ia.undo = append(ia.undo, snapshot{runes: ia.runes, cursor: ia.cursor})
+	if len(ia.undo) > maxUndoSteps {
+		ia.undo = ia.undo[1:]
+	}
+}
+
+// restore 將目前狀態推入 to 堆疊，並還原 from 堆疊最後一個快照。
+func (ia *InputArea) restore(from, to *[]snapshot) {
+	if len(*from) == 0 {
+		return
+	}
+	*to = append(*to, snapshot{runes: ia.runes, cursor: ia.cursor})
+	s := (*from)[len(*from)-1]
+	*from = (*from)[:len(*from)-1]
+	ia.runes, ia.cursor = s.runes, s.cursor
+}
+
+// undoEdit 復原上一個編輯步驟。
+func (ia *InputArea) undoEdit() {
+	ia.restore(&ia.undo, &ia.redo)
+}
+
+// redoEdit 重做上一個被復原的編輯步驟。
+func (ia *InputArea) redoEdit() {
+	ia.restore(&ia.redo, &ia.undo)
+}
+
+// kill 刪除 [from, to) 範圍的文字並放入 kill ring。
+// 連續的刪除會合併為同一個項目：向前刪除（Ctrl+U/W）接在前面，向後刪除（Ctrl+K）接在後面。
+func (ia *InputArea) kill(prev editKind, from, to int, backward bool) {
+	if from >= to {
+		return
+	}
+	text := string(ia.runes[from:to])
+	ia.record(prev, editKill)
+	if prev == editKill && len(ia.killRing) > 0 {
+		last := &ia.killRing[len(ia.killRing)-1]
+		if backward {
+			*last = text + *last
+		} else {
+			*last += text
+		}
+	} else {
+		ia.killRing = append(ia.killRing, text)
+		if len(ia.killRing) > killRingSize {
+			ia.killRing = ia.killRing[1:]
+		}
+	}
+	ia.deleteRange(from, to)
+}
+
+// killToLineEnd 刪除游標到行尾的文字；游標已在行尾時刪除換行字元，與 Emacs 的 Ctrl+K 相同。
+func (ia *InputArea) killToLineEnd(prev editKind) {
+	end := ia.lineEnd()
+	if end == ia.cursor && end < len(ia.runes) {
+		end++
+	}
+	ia.kill(prev, ia.cursor, end, false)
+}
+
+// yank 在游標位置貼上 kill ring 最新的項目。
+func (ia *InputArea) yank(prev editKind) {
+	if len(ia.killRing) == 0 {
+		return
+	}
+	ia.record(prev, editYank)
+	ia.yankIndex = len(ia.killRing) - 1
+	ia.yankStart = ia.cursor
+	ia.insert([]rune(ia.killRing[ia.yankIndex]))
+}
+
+// yankPop 緊接在貼上之後，以 kill ring 中較舊的項目取代剛貼上的文字。
+func (ia *InputArea) yankPop(prev editKind) {
+	if prev != editYank || len(ia.killRing) == 0 {
+		return
+	}
+	// AI 心智註解: 不另外保存快照，復原時直接回到貼上前的狀態。
+	ia.lastEdit = editYank
+	ia.yankIndex = (ia.yankIndex - 1 + len(ia.killRing)) % len(ia.killRing)
+	ia.deleteRange(ia.yankStart, ia.cursor)
+	ia.insert([]rune(ia.killRing[ia.yankIndex]))
+}
@@ -0,0 +1,85 @@
+package tui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInputAreaUndoCoalescesTyping(t *testing.T) {
+	// AI 心智註解: 連續輸入的多個中文字合併為一個復原步驟，移動游標後重新開始新的步驟。
+	ia := pressKeys(NewInputArea(), typeText("筆"), typeText("記"), typeText("本"))
+	ia = pressKeys(ia, tea.KeyMsg{Type: tea.KeyLeft}, typeText("簿"))
+	assert.Equal(t, "筆記簿本", ia.Text())
+
+	ia = pressKeys(ia, tea.KeyMsg{Type: tea.KeyCtrlZ})
+	assert.Equal(t, "筆記本", ia.Text())
+	assert.Equal(t, 2, ia.cursor)
+
+	ia = pressKeys(ia, tea.KeyMsg{Type: tea.KeyCtrlZ})
+	assert.Equal(t, "", ia.Text())
+
+	// AI 心智註解: 已無可復原的步驟時維持原狀。
+	ia = pressKeys(ia, tea.KeyMsg{Type: tea.KeyCtrlZ})
+	assert.Equal(t, "", ia.Text())
+
+	ia = pressKeys(ia, tea.KeyMsg{Type: tea.KeyCtrlR}, tea.KeyMsg{Type: tea.KeyCtrlR})
+	assert.Equal(t, "筆記簿本", ia.Text())
+	assert.Equal(t, 3, ia.cursor)
+}
+
+func TestInputAreaUndoRecoversDeletion(t *testing.T) {
+	// AI 心智註解: 連續 Backspace 合併為一步，Ctrl+W 單獨一步，皆可復原。
+	ia := pressKeys(NewInputArea(), typeText("漢字 測試"))
+	ia = pressKeys(ia, tea.KeyMsg{Type: tea.KeyBackspace}, tea.KeyMsg{Type: tea.KeyBackspace})
+	assert.Equal(t, "漢字 ", ia.Text())
+	ia = pressKeys(ia, tea.KeyMsg{Type: tea.KeyCtrlW})
+	assert.Equal(t, "", ia.Text())
+
+	ia = pressKeys(ia, tea.KeyMsg{Type: tea.KeyCtrlZ})
+	assert.Equal(t, "漢字 ", ia.Text())
+	ia = pressKeys(ia, tea.KeyMsg{Type: tea.KeyCtrlZ})
+	assert.Equal(t, "漢字 測試", ia.Text())
+
+	// AI 心智註解: 復原後進行新的編輯會清除重做堆疊。
+	ia = pressKeys(ia, typeText("！"), tea.KeyMsg{Type: tea.KeyCtrlR})
+	assert.Equal(t, "漢字 測試！", ia.Text())
+}
+
+func TestInputAreaKillRing(t *testing.T) {
+	// AI 心智註解: Ctrl+K 刪到行尾，Ctrl+U 刪到行首，Ctrl+Y 貼回最新刪除的文字。
+	ia := pressKeys(NewInputArea(), typeText("第一行"), tea.KeyMsg{Type: tea.KeyCtrlJ}, typeText("第二行"))
+	ia = pressKeys(ia, tea.KeyMsg{Type: tea.KeyLeft}, tea.KeyMsg{Type: tea.KeyCtrlK})
+	assert.Equal(t, "第一行\n第二", ia.Text())
+
+	ia = pressKeys(ia, tea.KeyMsg{Type: tea.KeyCtrlU})
+	assert.Equal(t, "第一行\n", ia.Text())
+	assert.Equal(t, []string{"第二行"}, ia.killRing, "連續刪除應合併為同一個項目")
+
+	ia = pressKeys(ia, tea.KeyMsg{Type: tea.KeyUp}, tea.KeyMsg{Type: tea.KeyCtrlY})
+	assert.Equal(t, "第二行第一行\n", ia.Text())
+}
+
+func TestInputAreaKillLineJoinsAtLineEnd(t *testing.T) {
+	// AI 心智註解: 游標位於行尾時 Ctrl+K 刪除換行字元，讓下一行接上來。
+	ia := pressKeys(NewInputArea(), typeText("上"), tea.KeyMsg{Type: tea.KeyCtrlJ}, typeText("下"))
+	ia = pressKeys(ia, tea.KeyMsg{Type: tea.KeyUp}, tea.KeyMsg{Type: tea.KeyEnd}, tea.KeyMsg{Type: tea.KeyCtrlK})
+	assert.Equal(t, "上下", ia.Text())
+}
+
+func TestInputAreaYankPopCyclesKillRing(t *testing.T) {
+	// AI 心智註解: Alt+Y 緊接在 Ctrl+Y 之後，以較舊的項目取代剛貼上的文字。
+	ia := pressKeys(NewInputArea(), typeText("甲 乙"))
+	ia = pressKeys(ia, tea.KeyMsg{Type: tea.KeyCtrlW}, tea.KeyMsg{Type: tea.KeyLeft}, tea.KeyMsg{Type: tea.KeyCtrlW})
+	assert.Equal(t, " ", ia.Text())
+	assert.Equal(t, []string{"乙", "甲"}, ia.killRing)
+
+	ia = pressKeys(ia, tea.KeyMsg{Type: tea.KeyCtrlY})
+	assert.Equal(t, "甲 ", ia.Text())
+	ia = pressKeys(ia, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'y'}, Alt: true})
+	assert.Equal(t, "乙 ", ia.Text())
+
+	ia = pressKeys(ia, tea.KeyMsg{Type: tea.KeyCtrlZ})
+	assert.Equal(t, " ", ia.Text())
+}
@@ -0,0 +1,313 @@
+// Package tui 提供了終端使用者介面 (TUI) 的實現。
+package tui
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+)
+
+// keyMap 定義 TUI 中可由使用者重新對應的快捷鍵。
+type keyMap struct {
+	Up             key.Binding // 向上移動游標。
+	Down           key.Binding // 向下移動游標。
+	PageUp         key.Binding // 向上捲動一頁。
+	PageDown       key.Binding // 向下捲動一頁。
+	Open           key.Binding // 查看選中的筆記。
+	Filter         key.Binding // 篩選列表。
+	Search         key.Binding // 在筆記內搜尋。
+	NextMatch      key.Binding // 跳到下一個搜尋結果。
+	PrevMatch      key.Binding // 跳到上一個搜尋結果。
+	ToggleRaw      key.Binding // 切換 Markdown 渲染與原始碼。
+	Preview        key.Binding // 切換列表視圖的預覽窗格。
+	Sort           key.Binding // 切換列表的排序鍵。
+	Reverse        key.Binding // 反轉列表的排序方向。
+	Group          key.Binding // 切換列表的分組方式。
+	Pin            key.Binding // 切換選中筆記的釘選狀態。
+	Jump           key.Binding // 開啟釘選筆記，第 n 個按鍵對應第 n 則。
+	Select         key.Binding // 切換筆記的選取狀態。
+	SelectRange    key.Binding // 開始或結束範圍選取。
+	AddTags        key.Binding // 為選取的筆記加上標籤。
+	RemoveTags     key.Binding // 從選取的筆記移除標籤。
+	Move           key.Binding // 將選取的筆記移到其他資料夾。
+	Trash          key.Binding // 將選取的筆記移到垃圾桶。
+	CopySelection  key.Binding // 將選取的筆記複製到剪貼簿。
+	Yank           key.Binding // 複製筆記的內容、標題、路徑或連結。
+	PasteClipboard key.Binding // 在建立視圖中貼上剪貼簿的內容。
+	Back           key.Binding // 返回上一個視圖。
+	NextField      key.Binding // 建立視圖中移到下一個欄位。
+	PrevField      key.Binding // 建立視圖中移到上一個欄位。
+	New            key.Binding // 建立新筆記。
+	Edit           key.Binding // 以外部編輯器開啟筆記。
+	Tasks          key.Binding // 開啟待辦事項視圖。
+	ToggleTask     key.Binding // 切換待辦項目的勾選狀態。
+	ErrorLog       key.Binding // 開啟錯誤紀錄。
+	Drafts         key.Binding // 開啟草稿視圖。
+	Discard        key.Binding // 捨棄選中的草稿。
+	Palette        key.Binding // 開啟命令面板。
+	Help           key.Binding // 切換完整說明。
+	Quit           key.Binding // 退出（輸入文字時停用）。
+	ForceQuit      key.Binding // 在任何視圖中退出。
+}
+
+// defaultKeyMap 返回預設的快捷鍵對應。
+func defaultKeyMap() keyMap {
+	return keyMap{
+		Up:             key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", i18n.T("key.up"))),
+		Down:           key.NewBinding(key.WithKeys("down", "j"), key.WithHelp("↓/j", i18n.T("key.down"))),
+		PageUp:         key.NewBinding(key.WithKeys("pgup"), key.WithHelp("pgup", i18n.T("key.page_up"))),
+		PageDown:       key.NewBinding(key.WithKeys("pgdown"), key.WithHelp("pgdown", i18n.T("key.page_down"))),
+		Open:           key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", i18n.T("key.open"))),
+		Filter:         key.NewBinding(key.WithKeys("/"), key.WithHelp("/", i18n.T("key.filter"))),
+		Search:         key.NewBinding(key.WithKeys("/"), key.WithHelp("/", i18n.T("key.search"))),
+		NextMatch:      key.NewBinding(key.WithKeys("n"), key.WithHelp("n", i18n.T("key.next_match"))),
+		PrevMatch:      key.NewBinding(key.WithKeys("N"), key.WithHelp("N", i18n.T("key.prev_match"))),
+		ToggleRaw:      key.NewBinding(key.WithKeys("r"), key.WithHelp("r", i18n.T("key.toggle_raw"))),
+		Preview:        key.NewBinding(key.WithKeys("p"), key.WithHelp("p", i18n.T("key.preview"))),
+		Sort:           key.NewBinding(key.WithKeys("s"), key.WithHelp("s", i18n.T("key.sort"))),
+		Reverse:        key.NewBinding(key.WithKeys("S"), key.WithHelp("S", i18n.T("key.reverse"))),
+		Group:          key.NewBinding(key.WithKeys("g"), key.WithHelp("g", i18n.T("key.group"))),
+		Pin:            key.NewBinding(key.WithKeys("*"), key.WithHelp("*", i18n.T("key.pin"))),
+		Jump:           key.NewBinding(key.WithKeys("1", "2", "3", "4", "5", "6", "7", "8", "9"), key.WithHelp("1-9", i18n.T("key.jump"))),
+		Select:         key.NewBinding(key.WithKeys(" "), key.WithHelp("space", i18n.T("key.select_note"))),
+		SelectRange:    key.NewBinding(key.WithKeys("V"), key.WithHelp("V", i18n.T("key.select_range"))),
+		AddTags:        key.NewBinding(key.WithKeys("+"), key.WithHelp("+", i18n.T("key.add_tags"))),
+		RemoveTags:     key.NewBinding(key.WithKeys("-"), key.WithHelp("-", i18n.T("key.remove_tags"))),
+		Move:           key.NewBinding(key.WithKeys("m"), key.WithHelp("m", i18n.T("key.move"))),
+		Trash:          key.NewBinding(key.WithKeys("d"), key.WithHelp("d", i18n.T("key.trash"))),
+		CopySelection:  key.NewBinding(key.WithKeys("Y"), key.WithHelp("Y", i18n.T("key.copy_selection"))),
+		Yank:           key.NewBinding(key.WithKeys("y"), key.WithHelp("y", i18n.T("key.yank"))),
+		PasteClipboard: key.NewBinding(key.WithKeys("ctrl+v"), key.WithHelp("ctrl+v", i18n.T("key.paste_clipboard"))),
+		Back:           key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", i18n.T("key.back"))),
+		NextField:      key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", i18n.T("key.next_field"))),
+		PrevField:      key.NewBinding(key.WithKeys("shift+tab"), key.WithHelp("shift+tab", i18n.T("key.prev_field"))),
+		New:            key.NewBinding(key.WithKeys("n"), key.WithHelp("n", i18n.T("key.new"))),
+		Edit:           key.NewBinding(key.WithKeys("e"), key.WithHelp("e", i18n.T("key.edit"))),
+		Tasks:          key.NewBinding(key.WithKeys("t"), key.WithHelp("t", i18n.T("key.tasks"))),
+		ToggleTask:     key.NewBinding(key.WithKeys("x"), key.WithHelp("x", i18n.T("key.toggle_task"))),
+		ErrorLog:       key.NewBinding(key.WithKeys("!"), key.WithHelp("!", i18n.T("key.error_log"))),
+		Drafts:         key.NewBinding(key.WithKeys("D"), key.WithHelp("D", i18n.T("key.drafts"))),
+		Discard:        key.NewBinding(key.WithKeys("d"), key.WithHelp("d", i18n.T("key.discard"))),
+		Palette:        key.NewBinding(key.WithKeys("ctrl+p"), key.WithHelp("ctrl+p", i18n.T("key.palette"))),
+		Help:           key.NewBinding(key.WithKeys("?"), key.WithHelp("?", i18n.T("key.help"))),
+		Quit:           key.NewBinding(key.WithKeys("q"), key.WithHelp("q", i18n.T("key.quit"))),
+		ForceQuit:      key.NewBinding(key.WithKeys("ctrl+c"), key.WithHelp("ctrl+c", i18n.T("key.quit"))),
+	}
+}
+
+// actions 返回配置檔案 [keys] 區段中的動作名稱與對應的快捷鍵。
+func (k *keyMap) actions() map[string]*key.Binding {
+	return map[string]*key.Binding{
+		"up":              &k.Up,
+		"down":            &k.Down,
+		"page_up":         &k.PageUp,
+		"page_down":       &k.PageDown,
+		"open":            &k.Open,
+		"filter":          &k.Filter,
+		"search":          &k.Search,
+		"next_match":      &k.NextMatch,
+		"prev_match":      &k.PrevMatch,
+		"toggle_raw":      &k.ToggleRaw,
+		"preview":         &k.Preview,
+		"sort":            &k.Sort,
+		"reverse":         &k.Reverse,
+		"group":           &k.Group,
+		"pin":             &k.Pin,
+		"jump":            &k.Jump,
+		"select":          &k.Select,
+		"select_range":    &k.SelectRange,
+		"add_tags":        &k.AddTags,
+		"remove_tags":     &k.RemoveTags,
+		"move":            &k.Move,
+		"trash":           &k.Trash,
+		"copy_selection":  &k.CopySelection,
+		"yank":            &k.Yank,
+		"paste_clipboard": &k.PasteClipboard,
+		"back":            &k.Back,
+		"next_field":      &k.NextField,
+		"prev_field":      &k.PrevField,
+		"new":             &k.New,
+		"edit":            &k.Edit,
+		"tasks":           &k.Tasks,
+		"toggle_task":     &k.ToggleTask,
+		"error_log":       &k.ErrorLog,
+		"drafts":          &k.Drafts,
+		"discard":         &k.Discard,
+		"palette":         &k.Palette,
+		"help":            &k.Help,
+		"quit":            &k.Quit,
+		"force_quit":      &k.ForceQuit,
+	}
+}
+
+// newKeyMap 以配置檔案中的設定覆蓋預設快捷鍵。
+// 空的按鍵列表會停用該動作；未知的動作名稱會被略過，其他設定照常套用，並返回列出所有未知動作的錯誤。
+func newKeyMap(overrides map[string][]string) (keyMap, error) {
+	k := defaultKeyMap()
+	actions := k.actions()
+	// AI 心智註解: 依名稱排序走訪，錯誤訊息的順序才不會隨 map 走訪順序改變。
+	var invalid []string
+	for _, name := range slices.Sorted(maps.Keys(overrides)) {
+		keys := overrides[name]
+		b, ok := actions[name]
+		if !ok {
+			invalid = append(invalid, i18n.T("key.unknown_action", name))
+			continue
+		}
+		if len(keys) == 0 {
+			b.SetEnabled(false)
+			continue
+		}
+		// AI 心智註解: 說明文字顯示使用者實際設定的按鍵，避免說明與行為不一致。
+		b.SetKeys(keys...)
+		b.SetHelp(strings.Join(keys, "/"), b.Help().Desc)
+	}
+	if len(invalid) > 0 {
+		return k, errors.New(strings.Join(invalid, "; "))
+	}
+	return k, nil
+}
+
+// composerKeys 返回輸入區域固定的按鍵，只用於顯示說明。
+func composerKeys() []key.Binding {
+	return []key.Binding{
+		key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", i18n.T("key.submit"))),
+		key.NewBinding(key.WithKeys("ctrl+j"), key.WithHelp("ctrl+j", i18n.T("key.newline"))),
+	}
+}
+
+// fieldAcceptKey 返回表單中標題與標籤欄位以 Enter 移到下一欄的按鍵，只用於顯示說明。
+func fieldAcceptKey() key.Binding {
+	return key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", i18n.T("key.next_field")))
+}
+
+// tagSeparatorKey 返回標籤欄位分隔標籤的按鍵，只用於顯示說明。
+func tagSeparatorKey() key.Binding {
+	return key.NewBinding(key.WithKeys(","), key.WithHelp(",", i18n.T("key.tag_separator")))
+}
+
+// suggestionKeys 返回標籤欄位選擇與接受補全候選的按鍵，只用於顯示說明。
+func suggestionKeys() []key.Binding {
+	return []key.Binding{
+		key.NewBinding(key.WithKeys("up", "down"), key.WithHelp("↑/↓", i18n.T("key.select_tag"))),
+		key.NewBinding(key.WithKeys("right"), key.WithHelp("→", i18n.T("key.accept_tag"))),
+	}
+}
+
+// filterAcceptKey 返回篩選輸入中套用篩選的按鍵，只用於顯示說明。
+func filterAcceptKey() key.Binding {
+	return key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", i18n.T("key.apply_filter")))
+}
+
+// searchAcceptKey 返回筆記內搜尋輸入中執行搜尋的按鍵，只用於顯示說明。
+func searchAcceptKey() key.Binding {
+	return key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", i18n.T("key.search")))
+}
+
+// paletteRunKey 返回命令面板中執行選中項目的按鍵，只用於顯示說明。
+func paletteRunKey() key.Binding {
+	return key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", i18n.T("key.run")))
+}
+
+// paletteMoveKeys 返回命令面板中移動游標的按鍵，只用於顯示說明。
+func paletteMoveKeys() key.Binding {
+	return key.NewBinding(key.WithKeys("up", "down"), key.WithHelp("↑/↓", i18n.T("key.select")))
+}
+
+// bulkAcceptKey 返回批次動作輸入中送出的按鍵，只用於顯示說明。
+func bulkAcceptKey() key.Binding {
+	return key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", i18n.T("key.apply")))
+}
+
+// trashConfirmKey 返回確認移到垃圾桶的按鍵，只用於顯示說明。
+func trashConfirmKey() key.Binding {
+	return key.NewBinding(key.WithKeys("y"), key.WithHelp("y", i18n.T("key.confirm")))
+}
+
+// helpKeys 實作 help.KeyMap，列出目前視圖可用的快捷鍵。
+type helpKeys struct {
+	short []key.Binding
+	full  [][]key.Binding
+}
+
+// ShortHelp 返回簡短說明中的快捷鍵。
+func (h helpKeys) ShortHelp() []key.Binding {
+	return h.short
+}
+
+// FullHelp 返回完整說明中依欄分組的快捷鍵。
+func (h helpKeys) FullHelp() [][]key.Binding {
+	return h.full
+}
+
+// viewHelpKeys 依目前視圖返回說明列顯示的快捷鍵。
+func (m model) viewHelpKeys() help.KeyMap {
+	k := m.keys
+	switch m.currentView {
+	case detailView:
+		if m.searching {
+			return helpKeys{short: []key.Binding{searchAcceptKey(), k.Back, k.ForceQuit}}
+		}
+		return helpKeys{
+			short: []key.Binding{k.Down, k.PageDown, k.Search, k.ToggleRaw, k.Edit, k.Yank, k.Back, k.Help, k.Quit},
+			full: [][]key.Binding{
+				{k.Up, k.Down, k.PageUp, k.PageDown},
+				{k.Search, k.NextMatch, k.PrevMatch, k.ToggleRaw},
+				{k.Edit, k.Yank, k.Back, k.ErrorLog, k.Help, k.Quit, k.ForceQuit},
+			},
+		}
+	case createView:
+		short := append(m.formHelpKeys(), k.NextField, k.PasteClipboard, k.Back, k.PageUp, k.PageDown, k.ForceQuit)
+		return helpKeys{short: short, full: [][]key.Binding{short}}
+	case tasksView:
+		return helpKeys{
+			short: []key.Binding{k.Up, k.Down, k.ToggleTask, k.Back, k.Help, k.Quit},
+			full:  [][]key.Binding{{k.Up, k.Down}, {k.ToggleTask, k.Back}, {k.ErrorLog, k.Help, k.Quit, k.ForceQuit}},
+		}
+	case draftsView:
+		return helpKeys{
+			short: []key.Binding{k.Up, k.Down, k.Open, k.Discard, k.Back, k.Help, k.Quit},
+			full:  [][]key.Binding{{k.Up, k.Down}, {k.Open, k.Discard, k.Back}, {k.Help, k.Quit, k.ForceQuit}},
+		}
+	case paletteView:
+		short := []key.Binding{paletteRunKey(), paletteMoveKeys(), k.Back, k.ForceQuit}
+		return helpKeys{short: short, full: [][]key.Binding{short}}
+	case errorLogView:
+		return helpKeys{
+			short: []key.Binding{k.Back, k.Help, k.Quit},
+			full:  [][]key.Binding{{k.Back, k.ErrorLog}, {k.Help, k.Quit, k.ForceQuit}},
+		}
+	}
+	switch m.bulk {
+	case bulkTrash:
+		cancel := key.NewBinding(key.WithKeys("n"), key.WithHelp("n", i18n.T("key.cancel")))
+		short := []key.Binding{trashConfirmKey(), cancel}
+		return helpKeys{short: short, full: [][]key.Binding{short}}
+	case bulkAddTags, bulkRemoveTags, bulkMove:
+		short := []key.Binding{bulkAcceptKey(), k.Back, k.ForceQuit}
+		return helpKeys{short: short, full: [][]key.Binding{short}}
+	}
+	if m.filtering {
+		short := []key.Binding{filterAcceptKey(), k.Back, k.ForceQuit}
+		return helpKeys{short: short, full: [][]key.Binding{short}}
+	}
+	return m.listHelpKeys()
+}
+
+// helpView 渲染目前視圖的說明列，'?' 切換簡短與完整說明；等待選擇複製內容時改為顯示複製提示。
+func (m model) helpView() string {
+	if m.yanking {
+		return m.yankPrompt()
+	}
+	keys := m.viewHelpKeys()
+	// AI 心智註解: 建立視圖、命令面板、篩選模式與批次動作的輸入中 '?' 屬於輸入內容，無法切換，因此固定顯示簡短說明。
+	if m.currentView == createView || m.currentView == paletteView || m.filtering || m.searching || m.bulk != bulkNone {
+		return m.help.ShortHelpView(keys.ShortHelp())
+	}
+	return m.help.View(keys)
+}
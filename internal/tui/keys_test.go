@@ -0,0 +1,106 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+)
+
+// setupTestConfig 建立臨時配置目錄並寫入 config.toml。
+func setupTestConfig(t *testing.T, content string) {
+	old := storage.GetTestConfigHome()
+	storage.SetTestConfigHome(t.TempDir())
+	t.Cleanup(func() { storage.SetTestConfigHome(old) })
+
+	dir, err := storage.GetConfigDir()
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "config.toml"), []byte(content), 0644))
+}
+
+func TestNewKeyMap_Overrides(t *testing.T) {
+	k, err := newKeyMap(map[string][]string{"quit": {"ctrl+q"}, "tasks": {}})
+	require.NoError(t, err)
+
+	assert.True(t, key.Matches(tea.KeyMsg{Type: tea.KeyCtrlQ}, k.Quit))
+	assert.False(t, key.Matches(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}}, k.Quit))
+	assert.Equal(t, "ctrl+q", k.Quit.Help().Key)
+	assert.False(t, k.Tasks.Enabled(), "空的按鍵列表應停用動作")
+
+	// 未知的動作只略過該項，其他覆蓋照常套用；錯誤依名稱排序列出所有未知動作。
+	k, err = newKeyMap(map[string][]string{"fly": {"f"}, "quit": {"ctrl+q"}, "dive": {"d"}})
+	assert.EqualError(t, err, `未知的快捷鍵動作 "dive"; 未知的快捷鍵動作 "fly"`)
+	assert.True(t, key.Matches(tea.KeyMsg{Type: tea.KeyCtrlQ}, k.Quit))
+}
+
+// TestUpdate_QTypesInCreateView 測試建立視圖中的 'q' 屬於輸入內容而非退出。
+func TestUpdate_QTypesInCreateView(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	m := openForm(t, loadedModel())
+
+	updatedModel, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+	m = updatedModel.(model)
+	assert.Nil(t, cmd)
+	assert.Equal(t, "q", m.titleInput.Value())
+
+	// AI 心智註解: Ctrl+C 先寫入草稿，寫入完成後才退出。
+	updatedModel, cmd = m.Update(tea.KeyMsg{Type: tea.KeyCtrlC})
+	require.NotNil(t, cmd)
+	_, cmd = updatedModel.Update(cmd())
+	require.NotNil(t, cmd)
+	assert.Equal(t, tea.Quit(), cmd())
+}
+
+// TestUpdate_HelpToggle 測試 '?' 切換完整說明。
+func TestUpdate_HelpToggle(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	m := loadedModel()
+	short := m.View()
+	updatedModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'?'}})
+	m = updatedModel.(model)
+
+	assert.True(t, m.help.ShowAll)
+	assert.NotEqual(t, short, m.View())
+	assert.Contains(t, m.View(), "ctrl+c")
+}
+
+// TestInitialModel_ConfigKeys 測試配置檔案中的快捷鍵設定會套用到 TUI。
+func TestInitialModel_ConfigKeys(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	setupTestConfig(t, "[keys]\nnew = [\"a\"]\n")
+
+	m := loadedModel()
+	require.Empty(t, m.errorLog)
+
+	updatedModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	m = updatedModel.(model)
+	assert.Equal(t, listView, m.currentView)
+
+	updatedModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	m = updatedModel.(model)
+	assert.Equal(t, createView, m.currentView)
+	assert.Contains(t, m.View(), "esc 返回")
+}
+
+// TestInitialModel_UnknownKeyAction 測試未知的快捷鍵動作顯示配置警告，其他有效的覆蓋照常套用。
+func TestInitialModel_UnknownKeyAction(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	setupTestConfig(t, "[keys]\nfly = [\"f\"]\nquit = [\"ctrl+q\"]\n")
+	require.NoError(t, writeTestNote("NoteA", "Content A"))
+
+	m := loadedModel()
+	assert.Contains(t, m.statusView(), "未知的快捷鍵動作")
+	assert.Equal(t, []string{"ctrl+q"}, m.keys.Quit.Keys(), "有效的覆蓋應保留")
+	assert.NotEmpty(t, m.notes, "配置錯誤不應阻止載入筆記")
+}
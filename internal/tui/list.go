@@ -0,0 +1,331 @@
+// Package tui 提供了終端使用者介面 (TUI) 的實現。
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sahilm/fuzzy"
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+	"github.com/wtg42/ora-ora-ora/internal/listing"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+)
+
+// filterDateLayout 是篩選語法 after:/before: 使用的日期格式。
+const filterDateLayout = "2006-01-02"
+
+// listItem 是列表視圖中顯示的一筆筆記。
+type listItem struct {
+	note    *note.Note // 筆記本身。
+	matches []int      // 標題中符合模糊搜尋的字元位置（位元組索引）。
+	group   string     // 所屬分組的顯示名稱，不分組時為空字串。
+}
+
+// listFilter 是從篩選輸入解析出的條件。
+type listFilter struct {
+	text   string    // 模糊比對標題與標籤、並搜尋內容的文字。
+	tags   []string  // 筆記必須包含的標籤（不分大小寫），來自 #tag。
+	after  time.Time // 建立時間不早於此日期，來自 after:。
+	before time.Time // 建立時間早於此日期，來自 before:。
+}
+
+// parseFilter 解析篩選輸入，例如 "會議 #work after:2026-09-01"。
+// 日期格式錯誤時返回錯誤，其餘條件仍然有效。
+func parseFilter(query string) (listFilter, error) {
+	var f listFilter
+	var words []string
+	var errs []string
+	for _, field := range strings.Fields(query) {
+		switch {
+		case field == "#":
+			// AI 心智註解: 尚未輸入完成的標籤，略過以免在輸入途中清空列表。
+		case strings.HasPrefix(field, "#"):
+			f.tags = append(f.tags, strings.ToLower(field[1:]))
+		case strings.HasPrefix(field, "after:"), strings.HasPrefix(field, "before:"):
+			name, value, _ := strings.Cut(field, ":")
+			t, err := time.ParseInLocation(filterDateLayout, value, time.Local)
+			if err != nil {
+				errs = append(errs, i18n.T("list.invalid_date", field))
+				continue
+			}
+			if name == "after" {
+				f.after = t
+			} else {
+				f.before = t
+			}
+		default:
+			words = append(words, field)
+		}
+	}
+	f.text = strings.Join(words, " ")
+	if len(errs) > 0 {
+		return f, errors.New(strings.Join(errs, i18n.T("list.error_separator")))
+	}
+	return f, nil
+}
+
+// matchMeta 判斷筆記是否符合標籤與日期條件。
+func (f listFilter) matchMeta(n *note.Note) bool {
+	for _, tag := range f.tags {
+		found := false
+		for _, t := range n.Tags {
+			if strings.EqualFold(t, tag) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if !f.after.IsZero() && n.CreatedAt.Before(f.after) {
+		return false
+	}
+	if !f.before.IsZero() && !n.CreatedAt.Before(f.before) {
+		return false
+	}
+	return true
+}
+
+// filterNotes 依篩選條件返回列表項目。
+// 標題的模糊比對排在最前並依分數排序，其次是標籤的模糊比對；內容搜尋的結果由 addContentMatches 在背景完成後附加。
+func filterNotes(notes []*note.Note, f listFilter) []listItem {
+	var candidates []*note.Note
+	for _, n := range notes {
+		if f.matchMeta(n) {
+			candidates = append(candidates, n)
+		}
+	}
+	if f.text == "" {
+		items := make([]listItem, len(candidates))
+		for i, n := range candidates {
+			items[i] = listItem{note: n}
+		}
+		return items
+	}
+
+	var items []listItem
+	seen := map[*note.Note]bool{}
+	titles := make([]string, len(candidates))
+	tags := make([]string, len(candidates))
+	for i, n := range candidates {
+		titles[i] = n.Title
+		tags[i] = strings.Join(n.Tags, " ")
+	}
+	for _, match := range fuzzy.Find(f.text, titles) {
+		n := candidates[match.Index]
+		seen[n] = true
+		items = append(items, listItem{note: n, matches: match.MatchedIndexes})
+	}
+	for _, match := range fuzzy.Find(f.text, tags) {
+		if n := candidates[match.Index]; !seen[n] {
+			seen[n] = true
+			items = append(items, listItem{note: n})
+		}
+	}
+	return items
+}
+
+// addContentMatches 將內容搜尋的結果附加到列表末端，略過已列出或不符合標籤與日期條件的筆記。
+func (m *model) addContentMatches(found []*note.Note) {
+	// AI 心智註解: 搜尋結果以檔案路徑對應回已載入的筆記，保留列表中既有的順序與游標。
+	f, _ := parseFilter(m.filterInput.Value())
+	listed := make(map[string]bool, len(m.items))
+	for _, item := range m.items {
+		listed[item.note.Path] = true
+	}
+	byPath := make(map[string]*note.Note, len(m.notes))
+	for _, n := range m.notes {
+		byPath[n.Path] = n
+	}
+	for _, r := range found {
+		if n, ok := byPath[r.Path]; ok && !listed[n.Path] && f.matchMeta(n) {
+			listed[n.Path] = true
+			m.items = append(m.items, listItem{note: n})
+		}
+	}
+	m.items = m.groupItems(m.items)
+	m.selectPath(m.selectedPath)
+}
+
+// applyFilter 依篩選輸入與排序、分組重新計算列表項目，並盡量讓游標停留在原本選中的筆記。
+// 篩選文字不為空時返回在背景搜尋內容的指令。
+func (m *model) applyFilter() tea.Cmd {
+	// AI 心智註解: 篩選途中列表可能暫時為空，記住最後選中的筆記，條件放寬後游標回到它身上。
+	if selected := m.selectedNote(); selected != nil {
+		m.selectedPath = selected.Path
+	}
+	f, err := parseFilter(m.filterInput.Value())
+	m.filterErr = ""
+	if err != nil {
+		m.filterErr = err.Error()
+	}
+	// AI 心智註解: 先排序再篩選，沒有篩選文字時列表依排序顯示；有篩選文字時標題與標籤的比對結果仍依分數排列。
+	m.items = m.groupItems(filterNotes(listing.Sort(m.notes, m.order, m.views), f))
+	m.cursor = 0
+	m.selectPath(m.selectedPath)
+	return m.searchContent(f.text)
+}
+
+// selectedNote 返回游標所在的筆記，列表為空時返回 nil。
+func (m model) selectedNote() *note.Note {
+	if m.cursor < 0 || m.cursor >= len(m.items) {
+		return nil
+	}
+	return m.items[m.cursor].note
+}
+
+// selectPath 將游標移到指定路徑的筆記；不在列表中時維持原位並夾回有效範圍。
+func (m *model) selectPath(path string) {
+	for i, item := range m.items {
+		if item.note.Path == path {
+			m.cursor = i
+			return
+		}
+	}
+	m.cursor = max(min(m.cursor, len(m.items)-1), 0)
+}
+
+// newFilterInput 建立篩選輸入框。
+func newFilterInput() textinput.Model {
+	ti := textinput.New()
+	ti.Prompt = "/"
+	ti.Placeholder = i18n.T("list.filter_placeholder")
+	return ti
+}
+
+// startFilter 進入篩選模式。
+func (m model) startFilter() (tea.Model, tea.Cmd) {
+	m.filtering = true
+	return m, m.filterInput.Focus()
+}
+
+// clearFilter 清除篩選條件並離開篩選模式。
+func (m model) clearFilter() model {
+	m.filtering = false
+	m.filterInput.Blur()
+	m.filterInput.SetValue("")
+	m.applyFilter()
+	return m
+}
+
+// updateFilter 處理篩選模式中的按鍵：Enter 保留篩選結果，Esc 清除，上下鍵移動游標。
+func (m model) updateFilter(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Back):
+		return m.clearFilter(), nil
+	case msg.Type == tea.KeyEnter:
+		m.filtering = false
+		m.filterInput.Blur()
+		return m, nil
+	case msg.Type == tea.KeyUp:
+		if m.cursor > 0 {
+			m.cursor--
+		}
+		return m, nil
+	case msg.Type == tea.KeyDown:
+		if m.cursor < len(m.items)-1 {
+			m.cursor++
+		}
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.filterInput, cmd = m.filterInput.Update(msg)
+	return m, tea.Batch(cmd, m.applyFilter())
+}
+
+// highlightMatches 以 style 標示字串中指定位元組位置的字元。
+func highlightMatches(s string, matches []int, style lipgloss.Style) string {
+	if len(matches) == 0 {
+		return s
+	}
+	matched := make(map[int]bool, len(matches))
+	for _, i := range matches {
+		matched[i] = true
+	}
+	var b strings.Builder
+	for i, r := range s {
+		if matched[i] {
+			b.WriteString(style.Render(string(r)))
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// listHeader 返回列表視圖中列表上方的內容：標題列、批次動作的輸入、篩選列與草稿提示，結尾為一個空行。
+func (m model) listHeader() string {
+	var b strings.Builder
+	b.WriteString(m.styles.header.Render(i18n.T("list.header")))
+	if name := storage.CurrentVault(); name != "" {
+		b.WriteString("  " + m.styles.muted.Render(i18n.T("list.vault", name)))
+	}
+	if label := m.orderLabel(); label != "" {
+		b.WriteString("  " + m.styles.muted.Render(label))
+	}
+	if label := m.selectionLabel(); label != "" {
+		b.WriteString("  " + m.styles.match.Render(label))
+	}
+	b.WriteString(m.busyIndicator() + "\n")
+	if prompt := m.bulkPrompt(); prompt != "" {
+		b.WriteString(prompt + "\n")
+	}
+	if m.filtering || m.filterInput.Value() != "" {
+		b.WriteString(fmt.Sprintf("%s  (%d/%d)\n", m.filterInput.View(), len(m.items), len(m.notes)))
+		if m.filterErr != "" {
+			b.WriteString(m.styles.history[roleError].Render(m.filterErr) + "\n")
+		}
+	}
+	if notice := m.draftNoticeView(); notice != "" {
+		b.WriteString(notice + "\n")
+	}
+	b.WriteString("\n")
+	return b.String()
+}
+
+// listHeight 返回列表可用的行數：已知終端高度時扣除上方內容、說明列與前後空行，未知時返回 0 表示不限制。
+func (m model) listHeight(header, help string) int {
+	if m.height <= 0 {
+		return 0
+	}
+	return max(m.height-strings.Count(header, "\n")-lipgloss.Height(help)-2, 1)
+}
+
+// listViewString 渲染列表視圖，篩選中或已套用篩選時顯示篩選列與符合數量。
+// 終端夠寬時以雙欄顯示：左側列表含日期與標籤欄，右側預覽選中的筆記。
+func (m model) listViewString() string {
+	var b strings.Builder
+	header := m.listHeader()
+	b.WriteString(header)
+	help := m.footerView()
+
+	// AI 心智註解: 已知終端高度時，扣除標題、篩選列、說明列與前後空行，列表只顯示游標附近的項目。
+	height := m.listHeight(header, help)
+
+	switch {
+	case !m.notesLoaded && m.loading != "":
+		b.WriteString(i18n.T("list.loading") + "\n")
+	case !m.notesLoaded:
+		b.WriteString(i18n.T("list.canceled") + "\n")
+	case len(m.notes) == 0:
+		// 如果沒有筆記，則提示使用者建立新筆記。
+		b.WriteString(i18n.T("list.empty", m.keys.New.Help().Key) + "\n")
+	case len(m.items) == 0:
+		b.WriteString(i18n.T("list.no_match") + "\n")
+	case m.splitActive():
+		b.WriteString(m.splitBody(height) + "\n")
+	default:
+		// 遍歷篩選後的列表，顯示每個筆記的標題，並標記當前選中的筆記。
+		b.WriteString(m.listRows(m.width, height, false) + "\n")
+	}
+	b.WriteString("\n" + help + "\n")
+	return b.String()
+}
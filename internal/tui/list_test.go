@@ -0,0 +1,165 @@
+package tui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/bubbles/cursor"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+)
+
+// seedFilterNotes 建立篩選測試用的筆記。
+func seedFilterNotes(t *testing.T) {
+	base := time.Date(2026, 9, 1, 9, 0, 0, 0, time.Local)
+	for i, n := range []*note.Note{
+		{Title: "Weekly report", Content: "進度", Tags: []string{"work"}},
+		{Title: "購物清單", Content: "牛奶、雞蛋", Tags: []string{"home"}},
+		{Title: "Retro", Content: "weekly 回顧內容", Tags: []string{"Work"}},
+	} {
+		n.CreatedAt = base.AddDate(0, 0, i*10)
+		require.NoError(t, storage.SaveNote(n))
+	}
+}
+
+// typeFilter 進入篩選模式並輸入篩選文字。
+func typeFilter(t *testing.T, m model, query string) model {
+	updatedModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+	m = updatedModel.(model)
+	require.True(t, m.filtering)
+	// AI 心智註解: 游標閃爍的指令會真的等待，固定游標讓 update 只執行內容搜尋。
+	m.filterInput.Cursor.SetMode(cursor.CursorStatic)
+	for _, r := range query {
+		m = update(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	return m
+}
+
+// itemTitles 返回列表項目的標題。
+func itemTitles(items []listItem) []string {
+	titles := make([]string, len(items))
+	for i, item := range items {
+		titles[i] = item.note.Title
+	}
+	return titles
+}
+
+func TestParseFilter(t *testing.T) {
+	f, err := parseFilter("週報 #Work after:2026-09-01 before:2026-10-01 草稿")
+	require.NoError(t, err)
+	assert.Equal(t, "週報 草稿", f.text)
+	assert.Equal(t, []string{"work"}, f.tags)
+	assert.Equal(t, time.Date(2026, 9, 1, 0, 0, 0, 0, time.Local), f.after)
+	assert.Equal(t, time.Date(2026, 10, 1, 0, 0, 0, 0, time.Local), f.before)
+
+	f, err = parseFilter("筆記 after:昨天")
+	assert.ErrorContains(t, err, "after:昨天")
+	assert.Equal(t, "筆記", f.text)
+}
+
+func TestHighlightMatches(t *testing.T) {
+	// AI 心智註解: 測試環境沒有顏色輸出，標示後的文字應與原文一致且不破壞多位元字元。
+	assert.Equal(t, "購物清單", highlightMatches("購物清單", []int{0, 6}, newStyles(builtinThemes[themeDark], true).match))
+}
+
+// TestListFilter_FuzzyTitleTagAndBody 測試標題模糊比對、標籤比對與內容搜尋都會列出，標題符合者優先。
+func TestListFilter_FuzzyTitleTagAndBody(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	seedFilterNotes(t)
+
+	m := typeFilter(t, loadedModel(), "wkly")
+	assert.Equal(t, []string{"Weekly report"}, itemTitles(m.items))
+	assert.Equal(t, []int{0, 3, 4, 5}, m.items[0].matches)
+
+	m = typeFilter(t, loadedModel(), "weekly")
+	assert.Equal(t, []string{"Weekly report", "Retro"}, itemTitles(m.items), "Retro 的內容包含 weekly")
+
+	m = typeFilter(t, loadedModel(), "home")
+	assert.Equal(t, []string{"購物清單"}, itemTitles(m.items))
+}
+
+// TestListFilter_TagAndDate 測試 #tag 與 after:/before: 篩選。
+func TestListFilter_TagAndDate(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	seedFilterNotes(t)
+
+	m := typeFilter(t, loadedModel(), "#work")
+	assert.Equal(t, []string{"Weekly report", "Retro"}, itemTitles(m.items))
+
+	m = typeFilter(t, loadedModel(), "#work after:2026-09-15")
+	assert.Equal(t, []string{"Retro"}, itemTitles(m.items))
+
+	m = typeFilter(t, loadedModel(), "before:2026-09-05")
+	assert.Equal(t, []string{"Weekly report"}, itemTitles(m.items))
+
+	m = typeFilter(t, loadedModel(), "after:2026-13")
+	assert.Contains(t, m.filterErr, "YYYY-MM-DD")
+	assert.Len(t, m.items, 3)
+}
+
+// TestListFilter_CursorFollowsFilteredList 測試游標與開啟的筆記對應篩選後的列表。
+func TestListFilter_CursorFollowsFilteredList(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	seedFilterNotes(t)
+
+	m := loadedModel()
+	updatedModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m = updatedModel.(model)
+	updatedModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m = updatedModel.(model)
+	require.Equal(t, "Retro", m.selectedNote().Title)
+
+	// AI 心智註解: 篩選後原本選中的筆記仍在列表中時，游標跟著它移動。
+	m = typeFilter(t, m, "#work")
+	assert.Equal(t, 1, m.cursor)
+	assert.Equal(t, "Retro", m.selectedNote().Title)
+
+	// AI 心智註解: 篩選模式中 'j' 屬於輸入內容，只有方向鍵移動游標。
+	updatedModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyUp})
+	m = updatedModel.(model)
+	updatedModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updatedModel.(model)
+	assert.False(t, m.filtering)
+	assert.Equal(t, "#work", m.filterInput.Value())
+
+	updatedModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m = updatedModel.(model)
+	updatedModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m = updatedModel.(model)
+	assert.Equal(t, 1, m.cursor, "游標不應超出篩選後的列表")
+
+	m = update(m, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, detailView, m.currentView)
+	assert.Equal(t, "weekly 回顧內容", m.selectedNoteContent)
+
+	// AI 心智註解: 返回列表後 Esc 清除篩選，游標仍停在同一則筆記。
+	updatedModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updatedModel.(model)
+	updatedModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updatedModel.(model)
+	assert.Empty(t, m.filterInput.Value())
+	assert.Len(t, m.items, 3)
+	assert.Equal(t, "Retro", m.selectedNote().Title)
+}
+
+// TestListFilter_EscCancels 測試篩選模式中 Esc 清除篩選，且 'q' 不會退出。
+func TestListFilter_EscCancels(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	seedFilterNotes(t)
+
+	m := typeFilter(t, loadedModel(), "q")
+	assert.Empty(t, m.items)
+	assert.Contains(t, m.View(), "沒有符合篩選條件的筆記")
+
+	updatedModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updatedModel.(model)
+	assert.False(t, m.filtering)
+	assert.Len(t, m.items, 3)
+}
@@ -0,0 +1,385 @@
+// Package tui 提供了終端使用者介面 (TUI) 的實現。
+package tui
+
+import (
+	"context"
+	"errors"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+	"github.com/wtg42/ora-ora-ora/internal/listing"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+	"github.com/wtg42/ora-ora-ora/internal/task"
+)
+
+// notesLoadedMsg 訊息表示背景載入所有筆記完成。
+type notesLoadedMsg struct {
+	seq      int // 發出讀取時的序號，用於丟棄已取消或過期的結果。
+	notes    []*note.Note
+	views    listing.Views // 查看紀錄，讀取失敗時為 nil。
+	viewsErr error         // 讀取查看紀錄的錯誤，不影響筆記列表。
+	err      error         // 載入失敗的錯誤；為 *storage.SkippedNotesError 時 notes 仍包含其餘筆記。
+}
+
+// noteOpenedMsg 訊息表示背景讀取要查看的筆記完成。
+type noteOpenedMsg struct {
+	seq  int
+	note *note.Note
+	err  error
+}
+
+// tasksLoadedMsg 訊息表示背景載入未完成的待辦項目完成。
+type tasksLoadedMsg struct {
+	seq   int
+	tasks []task.Task
+	err   error
+}
+
+// contentSearchMsg 訊息表示篩選的內容搜尋完成。
+type contentSearchMsg struct {
+	seq   int
+	notes []*note.Note
+	err   error
+}
+
+// noteSavedMsg 訊息表示建立視圖中的筆記已在背景儲存。
+type noteSavedMsg struct {
+	note       *note.Note
+	reply      string // 儲存成功時加入歷史區域的系統回應。
+	resetInput bool   // 儲存成功時是否清空輸入區。
+	err        error
+}
+
+// noteEditedMsg 訊息表示外部編輯器結束後，筆記已重新解析。
+type noteEditedMsg struct {
+	note *note.Note
+	err  error
+}
+
+// taskToggledMsg 訊息表示待辦項目已勾選並寫回來源筆記。
+type taskToggledMsg struct {
+	err error
+}
+
+// isSkipped 判斷 err 是否只是略過了部分無法讀取的筆記，此時其餘筆記仍可使用。
+func isSkipped(err error) bool {
+	var skipped *storage.SkippedNotesError
+	return errors.As(err, &skipped)
+}
+
+// newSpinner 建立讀取與儲存時顯示的 spinner。
+func newSpinner() spinner.Model {
+	return spinner.New(spinner.WithSpinner(spinner.Dot))
+}
+
+// startLoad 開始一項可取消的背景讀取，並取消仍在進行中的讀取。
+// 返回讀取使用的 context 與序號，結果訊息需帶回該序號。
+func (m *model) startLoad(status string) (context.Context, int) {
+	m.cancelLoading()
+	ctx, cancel := context.WithCancel(context.Background())
+	m.loadSeq++
+	m.loading = status
+	m.cancelLoad = cancel
+	return ctx, m.loadSeq
+}
+
+// finishLoad 判斷結果是否屬於目前的讀取，是則結束讀取狀態。
+func (m *model) finishLoad(seq int) bool {
+	if seq != m.loadSeq || m.cancelLoad == nil {
+		return false
+	}
+	m.cancelLoad()
+	m.cancelLoad = nil
+	m.loading = ""
+	return true
+}
+
+// cancelLoading 取消進行中的讀取，之後抵達的結果會因序號不符而被丟棄。
+func (m *model) cancelLoading() bool {
+	if m.cancelLoad == nil {
+		return false
+	}
+	m.cancelLoad()
+	m.cancelLoad = nil
+	m.loading = ""
+	m.loadSeq++
+	return true
+}
+
+// busy 判斷是否有進行中的背景讀取、儲存或內容搜尋。
+func (m model) busy() bool {
+	return m.loading != "" || m.saving > 0 || m.searchPending
+}
+
+// busyIndicator 返回附加在標題列後的 spinner 與狀態文字，閒置時返回空字串。
+func (m model) busyIndicator() string {
+	status := ""
+	switch {
+	case m.loading != "":
+		status = i18n.T("load.cancel_hint", m.loading, m.keys.Back.Help().Key)
+	case m.saving > 0:
+		status = i18n.T("load.saving")
+	case m.searchPending:
+		status = i18n.T("load.searching")
+	default:
+		return ""
+	}
+	return " " + m.spinner.View() + status
+}
+
+// loadNotes 返回在背景載入所有筆記的指令。
+func (m *model) loadNotes() tea.Cmd {
+	ctx, seq := m.startLoad(i18n.T("load.notes"))
+	return tea.Batch(func() tea.Msg {
+		notes, err := storage.LoadAllNotesContext(ctx)
+		if err != nil && !isSkipped(err) {
+			return notesLoadedMsg{seq: seq, err: err}
+		}
+		views, viewsErr := loadViews()
+		return notesLoadedMsg{seq: seq, notes: notes, views: views, viewsErr: viewsErr, err: err}
+	}, m.spinner.Tick)
+}
+
+// openNote 返回在背景讀取指定筆記以供查看的指令。
+func (m *model) openNote(path string) tea.Cmd {
+	ctx, seq := m.startLoad(i18n.T("load.note"))
+	return tea.Batch(func() tea.Msg {
+		if err := ctx.Err(); err != nil {
+			return noteOpenedMsg{seq: seq, err: err}
+		}
+		n, err := storage.LoadNote(path)
+		return noteOpenedMsg{seq: seq, note: n, err: err}
+	}, m.spinner.Tick)
+}
+
+// loadTasks 返回在背景載入未完成待辦項目的指令。
+func (m *model) loadTasks() tea.Cmd {
+	ctx, seq := m.startLoad(i18n.T("load.tasks"))
+	return tea.Batch(func() tea.Msg {
+		notes, err := storage.LoadAllNotesContext(ctx)
+		if err != nil && !isSkipped(err) {
+			return tasksLoadedMsg{seq: seq, err: err}
+		}
+		return tasksLoadedMsg{seq: seq, tasks: task.Filter{OpenOnly: true}.Apply(task.Collect(notes)), err: err}
+	}, m.spinner.Tick)
+}
+
+// searchContent 返回在背景搜尋筆記內容的指令，並取消上一次尚未完成的搜尋。
+// text 為空時只取消搜尋並返回 nil。
+func (m *model) searchContent(text string) tea.Cmd {
+	if m.cancelSearch != nil {
+		m.cancelSearch()
+		m.cancelSearch = nil
+	}
+	m.searchSeq++
+	m.searchPending = text != ""
+	if text == "" {
+		return nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancelSearch = cancel
+	seq := m.searchSeq
+	return tea.Batch(func() tea.Msg {
+		notes, err := storage.SearchContentContext(ctx, text)
+		return contentSearchMsg{seq: seq, notes: notes, err: err}
+	}, m.spinner.Tick)
+}
+
+// saveNote 返回在背景儲存新筆記的指令。
+// AI 心智註解: 寫入一旦開始就無法安全地中止，因此儲存不提供取消，只計數以顯示 spinner。
+func (m *model) saveNote(n *note.Note, reply string, resetInput bool) tea.Cmd {
+	m.saving++
+	return tea.Batch(func() tea.Msg {
+		return noteSavedMsg{note: n, reply: reply, resetInput: resetInput, err: storage.SaveNote(n)}
+	}, m.spinner.Tick)
+}
+
+// reloadEdited 返回在背景重新解析編輯後筆記的指令。
+func reloadEdited(path string) tea.Cmd {
+	return func() tea.Msg {
+		n, err := storage.ReloadEditedNote(path)
+		return noteEditedMsg{note: n, err: err}
+	}
+}
+
+// toggleTask 返回在背景勾選待辦項目並寫回來源筆記的指令。
+func (m *model) toggleTask(t task.Task) tea.Cmd {
+	m.saving++
+	return tea.Batch(func() tea.Msg {
+		n, err := storage.LoadNote(t.NotePath)
+		if err != nil {
+			return taskToggledMsg{err: errors.New(i18n.T("error.read_note", i18n.Error(err)))}
+		}
+		// AI 心智註解: 待辦事項視圖載入後筆記可能被外部編輯，校驗碼不符時不勾選，避免勾到別的項目。
+		content, _, err := task.Toggle(n.Content, t.Line, task.Checksum(t.Raw))
+		if err != nil {
+			return taskToggledMsg{err: errors.New(i18n.T("error.toggle_task", i18n.Error(err)))}
+		}
+		n.Content = content
+		if err := storage.UpdateNote(n); err != nil {
+			return taskToggledMsg{err: errors.New(i18n.T("error.save_note", i18n.Error(err)))}
+		}
+		return taskToggledMsg{}
+	}, m.spinner.Tick)
+}
+
+// updateLoad 處理背景讀取、儲存與 spinner 的訊息；不屬於這些訊息時 handled 為 false。
+func (m model) updateLoad(msg tea.Msg) (_ model, _ tea.Cmd, handled bool) {
+	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		// AI 心智註解: 閒置時不再排程下一次計時，spinner 停止轉動直到下一次讀取。
+		if !m.busy() {
+			return m, nil, true
+		}
+		var cmd tea.Cmd
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd, true
+
+	case notesLoadedMsg:
+		if !m.finishLoad(msg.seq) || errors.Is(msg.err, context.Canceled) {
+			return m, nil, true
+		}
+		if msg.err != nil && !isSkipped(msg.err) {
+			return m, m.setStatus(statusError, i18n.T("error.load_notes"), i18n.Error(msg.err)), true
+		}
+		m.notes = msg.notes
+		m.notesLoaded = true
+		m.mergeViews(msg.views)
+		cmd := m.applyFilter()
+		if m.pendingSelect != "" {
+			m.selectPath(m.pendingSelect)
+			m.pendingSelect = ""
+		}
+		if msg.viewsErr != nil {
+			cmd = tea.Batch(cmd, m.setStatus(statusWarning, i18n.T("error.load_views"), msg.viewsErr))
+		}
+		if msg.err != nil {
+			cmd = tea.Batch(cmd, m.setStatus(statusWarning, "%s", i18n.Error(msg.err)))
+		}
+		return m, cmd, true
+
+	case noteOpenedMsg:
+		if !m.finishLoad(msg.seq) || errors.Is(msg.err, context.Canceled) {
+			return m, nil, true
+		}
+		if msg.err != nil {
+			return m, m.setStatus(statusError, i18n.T("error.read_note"), i18n.Error(msg.err)), true
+		}
+		// AI 心智註解: 詳細視圖中點擊 [[標題]] 也會開啟筆記，直接換成連結的筆記；其他視圖表示使用者已離開列表。
+		if m.currentView != listView && m.currentView != detailView {
+			return m, nil, true
+		}
+		m = m.openDetail(msg.note)
+		return m, m.recordView(msg.note), true
+
+	case tasksLoadedMsg:
+		if !m.finishLoad(msg.seq) || errors.Is(msg.err, context.Canceled) {
+			return m, nil, true
+		}
+		if msg.err != nil && !isSkipped(msg.err) {
+			return m, m.setStatus(statusError, i18n.T("error.load_tasks"), i18n.Error(msg.err)), true
+		}
+		// AI 心智註解: 勾選後重新載入會移除已完成項目，游標夾回有效範圍。
+		m.tasks = msg.tasks
+		m.taskCursor = max(min(m.taskCursor, len(m.tasks)-1), 0)
+		if msg.err != nil {
+			return m, m.setStatus(statusWarning, "%s", i18n.Error(msg.err)), true
+		}
+		return m, nil, true
+
+	case contentSearchMsg:
+		if msg.seq != m.searchSeq || errors.Is(msg.err, context.Canceled) {
+			return m, nil, true
+		}
+		m.searchPending = false
+		m.cancelSearch = nil
+		// AI 心智註解: 略過的筆記已在載入列表時回報，搜尋只使用其餘筆記的結果。
+		if msg.err != nil && !isSkipped(msg.err) {
+			m.filterErr = i18n.T("error.search_content", i18n.Error(msg.err))
+			return m, nil, true
+		}
+		m.addContentMatches(msg.notes)
+		return m, nil, true
+
+	case noteSavedMsg:
+		m.saving--
+		// AI 心智註解: 儲存失敗時保留輸入區內容，使用者修正後可直接重新送出。
+		if msg.err != nil {
+			text := i18n.T("error.save_note", i18n.Error(msg.err))
+			m.appendHistory(roleError, text)
+			return m, m.setStatus(statusError, "%s", text), true
+		}
+		// AI 心智註解: 以對話方式呈現：使用者條目之後接著系統回應，筆記列表在背景重新載入。
+		m.appendHistory(roleUser, noteEntry(msg.note).text)
+		m.appendHistory(roleSystem, msg.reply)
+		// AI 心智註解: 表單的內容已成為筆記，捨棄對應的草稿；保留表單內容時草稿繼續有效。
+		var reset tea.Cmd
+		if msg.resetInput {
+			reset = tea.Batch(m.resetForm(), m.discardDraft())
+		}
+		m.layout()
+		return m, tea.Batch(m.loadNotes(), reset), true
+
+	case noteEditedMsg:
+		// AI 心智註解: 編輯後 front matter 損毀時只回報錯誤而不覆寫檔案，使用者可再次編輯修正。
+		if msg.err != nil {
+			return m, m.setStatus(statusError, i18n.T("error.edited_note_invalid"), i18n.Error(msg.err)), true
+		}
+		m.pendingSelect = msg.note.Path
+		if m.currentView == detailView {
+			m.detailNote = msg.note
+			m.selectedNoteContent = msg.note.Content
+			m.renderDetail()
+		}
+		return m, tea.Batch(m.loadNotes(), m.setStatus(statusInfo, i18n.T("load.note_updated"), msg.note.Title)), true
+
+	case notePinnedMsg:
+		m.saving--
+		if msg.err != nil {
+			return m, m.setStatus(statusError, i18n.T("error.pin"), i18n.Error(msg.err)), true
+		}
+		m.pendingSelect = msg.note.Path
+		format := i18n.T("load.unpinned")
+		if msg.note.Pinned {
+			format = i18n.T("load.pinned")
+		}
+		return m, tea.Batch(m.loadNotes(), m.setStatus(statusInfo, format, msg.note.Title)), true
+
+	case notesExportedMsg:
+		m.saving--
+		if msg.err != nil {
+			return m, m.setStatus(statusError, i18n.T("error.export"), i18n.Error(msg.err)), true
+		}
+		return m, m.setStatus(statusInfo, i18n.T("command.exported"), msg.count, msg.path), true
+
+	case bulkDoneMsg:
+		m, cmd := m.finishBulk(msg)
+		return m, cmd, true
+
+	case copiedMsg:
+		if msg.err != nil {
+			return m, m.setStatus(statusError, i18n.T("error.copy"), msg.err), true
+		}
+		return m, m.setStatus(statusInfo, "%s", msg.status), true
+
+	case clipboardPastedMsg:
+		next, cmd := m.insertClipboard(msg)
+		return next.(model), cmd, true
+
+	case viewRecordedMsg:
+		if msg.err != nil {
+			return m, m.setStatus(statusWarning, i18n.T("error.record_view"), msg.err), true
+		}
+		return m, nil, true
+
+	case taskToggledMsg:
+		m.saving--
+		if msg.err != nil {
+			return m, m.setStatus(statusError, "%v", msg.err), true
+		}
+		return m, tea.Batch(m.loadTasks(), m.setStatus(statusInfo, "%s", i18n.T("load.task_done"))), true
+	}
+	return m, nil, false
+}
@@ -0,0 +1,97 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+)
+
+// TestLoad_CancelInitialLoad 測試載入中按下 Esc 會取消讀取，之後抵達的結果被丟棄。
+func TestLoad_CancelInitialLoad(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	require.NoError(t, writeTestNote("NoteA", "Content A"))
+
+	m := InitialModel()
+	startup := m.Init()
+	assert.Contains(t, m.View(), "esc 取消")
+
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.False(t, m.busy())
+	m = runCmd(m, startup)
+	assert.Empty(t, m.notes)
+	assert.Contains(t, m.View(), "已取消載入筆記")
+}
+
+// TestLoad_StaleResultsIgnored 測試較舊的讀取與內容搜尋結果不會覆蓋較新的狀態。
+func TestLoad_StaleResultsIgnored(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	seedFilterNotes(t)
+
+	m := loadedModel()
+	stale := notesLoadedMsg{seq: m.loadSeq, notes: []*note.Note{{Title: "過期"}}}
+	m = update(m, stale)
+	assert.Len(t, m.notes, 3)
+
+	m = typeFilter(t, m, "weekly")
+	require.Equal(t, []string{"Weekly report", "Retro"}, itemTitles(m.items))
+	m = update(m, contentSearchMsg{seq: m.searchSeq - 1, notes: m.notes})
+	assert.Equal(t, []string{"Weekly report", "Retro"}, itemTitles(m.items))
+}
+
+// TestLoad_SaveShowsSpinner 測試儲存期間顯示狀態，完成後寫入歷史並在背景重新載入列表。
+func TestLoad_SaveShowsSpinner(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	m := pressKey(loadedModel(), tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	m = pressKey(m, typeText("標題"))
+	updatedModel, cmd := m.Update(SubmitMsg{Text: "內容"})
+	m = updatedModel.(model)
+	assert.True(t, m.busy())
+	assert.Contains(t, m.View(), "儲存中")
+
+	m = runCmd(m, cmd)
+	assert.False(t, m.busy())
+	assert.Equal(t, []string{"標題"}, noteTitles(m.notes))
+	assert.Equal(t, roleSystem, m.history[len(m.history)-1].role)
+}
+
+// TestLoad_SpinnerStopsWhenIdle 測試閒置時 spinner 不再排程下一次計時。
+func TestLoad_SpinnerStopsWhenIdle(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	m := InitialModel()
+	_, cmd := m.Update(m.spinner.Tick())
+	assert.NotNil(t, cmd, "載入中應持續轉動")
+
+	m = runCmd(m, m.Init())
+	_, cmd = m.Update(spinner.TickMsg{})
+	assert.Nil(t, cmd)
+}
+
+// TestLoad_SkipsMalformedNote 測試損毀的筆記只以警告回報，其餘筆記照常顯示。
+func TestLoad_SkipsMalformedNote(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	require.NoError(t, writeTestNote("NoteA", "Content A"))
+	dataDir, err := storage.GetDataDir()
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, "20240101000000-壞.md"), []byte("沒有 front matter"), 0644))
+
+	m := loadedModel()
+	assert.Equal(t, []string{"NoteA"}, noteTitles(m.notes))
+	require.NotNil(t, m.status)
+	assert.Equal(t, statusWarning, m.status.level)
+	assert.Contains(t, m.status.text, "略過 1 篇無法讀取的筆記")
+	assert.Contains(t, m.status.text, "20240101000000-壞.md")
+}
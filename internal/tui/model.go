@@ -2,21 +2,36 @@
 package tui
 
 import (
-	"fmt"
-	"strings"
+	"context"
+	"slices"
 
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wtg42/ora-ora-ora/internal/clipboard"
+	"github.com/wtg42/ora-ora-ora/internal/config"
+	"github.com/wtg42/ora-ora-ora/internal/draft"
+	"github.com/wtg42/ora-ora-ora/internal/editor"
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+	"github.com/wtg42/ora-ora-ora/internal/listing"
 	"github.com/wtg42/ora-ora-ora/internal/note"
-	"github.com/wtg42/ora-ora-ora/internal/storage"
+	"github.com/wtg42/ora-ora-ora/internal/task"
 )
 
 // viewState 是一個整數類型，用於表示 TUI 的當前視圖狀態。
 type viewState int
 
 const (
-	listView   viewState = iota // 列表視圖，顯示所有筆記的標題。
-	detailView                  // 詳細視圖，顯示單個筆記的內容。
-	createView                  // 建立視圖，用於建立新筆記。
+	listView     viewState = iota // 列表視圖，顯示所有筆記的標題。
+	detailView                    // 詳細視圖，顯示單個筆記的內容。
+	createView                    // 建立視圖，用於建立新筆記。
+	tasksView                     // 待辦事項視圖，依筆記分組顯示未完成的項目。
+	errorLogView                  // 錯誤紀錄視圖，列出最近的警告與錯誤。
+	draftsView                    // 草稿視圖，列出建立視圖中未送出的草稿。
+	paletteView                   // 命令面板，搜尋並執行動作或開啟筆記。
 )
 
 // SubmitMsg 訊息表示用戶提交了輸入。
@@ -24,171 +39,385 @@ type SubmitMsg struct {
 	Text string
 }
 
+// editorFinishedMsg 訊息表示外部編輯器已結束。
+type editorFinishedMsg struct {
+	path string // 被編輯的筆記檔案路徑。
+	err  error  // 編輯器執行時的錯誤。
+}
+
 // model 結構體包含了 TUI 應用程式的所有狀態。
 type model struct {
-	notes               []string  // 筆記標題列表。
-	cursor              int       // 當前選中的筆記索引。
-	currentView         viewState // 當前的視圖狀態。
-	selectedNoteContent string    // 當前查看的筆記內容。
-	newNoteTitle        string    // 新筆記的標題。
-	newNoteContent      string    // 新筆記的內容。
-	errorMessage        string    // 錯誤訊息，用於顯示給使用者。
-	inputArea           InputArea // 輸入區域組件。
+	notes               []*note.Note            // 所有已載入的筆記。
+	items               []listItem              // 列表視圖中經篩選後顯示的筆記。
+	cursor              int                     // 當前選中的列表項目索引。
+	filterInput         textinput.Model         // 列表視圖的篩選輸入框。
+	filtering           bool                    // 是否正在輸入篩選條件。
+	filterErr           string                  // 篩選條件的錯誤，例如日期格式錯誤。
+	selectedPath        string                  // 最後選中的筆記路徑，篩選後用於還原游標。
+	currentView         viewState               // 當前的視圖狀態。
+	selectedNoteContent string                  // 當前查看的筆記內容。
+	newNoteTitle        string                  // 新筆記的標題。
+	newNoteContent      string                  // 新筆記的內容。
+	inputArea           InputArea               // 輸入區域組件，作為建立視圖表單的內容欄位。
+	titleInput          textinput.Model         // 建立視圖表單的標題欄位。
+	tagsInput           textinput.Model         // 建立視圖表單的標籤欄位。
+	formFocus           formField               // 建立視圖表單中取得焦點的欄位。
+	tagSuggestions      []string                // 標籤欄位的補全候選。
+	suggestionIndex     int                     // 選中的補全候選索引。
+	formTried           bool                    // 是否已嘗試送出表單，之後才提示空白的必填欄位。
+	editor              string                  // 配置檔案中指定的編輯器指令。
+	tasks               []task.Task             // 待辦事項視圖中的未完成項目。
+	taskCursor          int                     // 待辦事項視圖中選中的項目索引。
+	width, height       int                     // 終端尺寸，由 tea.WindowSizeMsg 更新。
+	history             []historyEntry          // 歷史對話區域中的訊息。
+	historyView         viewport.Model          // 歷史對話區域的可捲動 viewport。
+	keys                keyMap                  // 快捷鍵對應，可由配置檔案覆蓋。
+	styles              styles                  // 依配置檔案的主題產生的樣式。
+	themeName           string                  // 目前使用的主題名稱，命令面板切換主題時更新。
+	customThemes        map[string]config.Theme // 配置檔案中的自訂主題。
+	vaults              config.Vaults           // 配置檔案中的筆記庫，可從命令面板切換。
+	help                help.Model              // 底部的快捷鍵說明。
+	detailNote          *note.Note              // 詳細視圖中顯示的筆記。
+	reader              viewport.Model          // 詳細視圖的可捲動 viewport。
+	readerLines         []string                // viewport 內容去除樣式後的各行，用於搜尋。
+	detailRaw           bool                    // 詳細視圖是否顯示原始 Markdown。
+	detailErr           string                  // Markdown 渲染錯誤。
+	detailSearch        textinput.Model         // 詳細視圖的搜尋輸入框。
+	searching           bool                    // 是否正在輸入搜尋文字。
+	searchHits          []int                   // 符合搜尋文字的行號。
+	searchIndex         int                     // 目前所在的搜尋結果索引。
+	pendingPaste        string                  // 等待使用者決定如何處理的大量貼上內容。
+	splitPane           bool                    // 列表視圖是否啟用列表與預覽的雙欄版面。
+	preview             *previewCache           // 預覽窗格的渲染快取。
+	spinner             spinner.Model           // 背景讀取與儲存時顯示的 spinner。
+	startup             tea.Cmd                 // Init 時執行的初始載入指令。
+	notesLoaded         bool                    // 筆記列表是否已載入完成。
+	loading             string                  // 進行中的背景讀取的狀態文字，閒置時為空字串。
+	loadSeq             int                     // 背景讀取的序號，結果序號不符時丟棄。
+	cancelLoad          context.CancelFunc      // 取消進行中的背景讀取。
+	saving              int                     // 進行中的背景儲存數量。
+	pendingSelect       string                  // 重新載入筆記後要選中的筆記路徑。
+	searchSeq           int                     // 內容搜尋的序號，結果序號不符時丟棄。
+	searchPending       bool                    // 內容搜尋是否進行中。
+	cancelSearch        context.CancelFunc      // 取消進行中的內容搜尋。
+	status              *statusMessage          // 狀態列目前顯示的訊息，沒有訊息時為 nil。
+	statusSeq           int                     // 狀態列訊息的序號。
+	errorLog            []statusMessage         // 最近的警告與錯誤。
+	prevView            viewState               // 開啟錯誤紀錄前的視圖。
+	draftID             string                  // 建立視圖目前自動儲存的草稿識別碼。
+	draftText           string                  // 最後一次寫入草稿的內容，未變更時略過寫入。
+	drafts              []draft.Draft           // 草稿視圖中的草稿。
+	draftCursor         int                     // 草稿視圖中選中的草稿索引。
+	draftNotice         bool                    // 是否在列表視圖提示還原啟動時發現的草稿。
+	order               listing.Options         // 列表的排序與分組。
+	views               listing.Views           // 每則筆記最近一次查看的時間，用於依最近查看排序。
+	paletteInput        textinput.Model         // 命令面板的搜尋輸入框。
+	paletteItems        []paletteItem           // 命令面板中符合搜尋文字的動作與筆記。
+	paletteCursor       int                     // 命令面板中選中的項目索引。
+	mouse               bool                    // 是否接收滑鼠事件，可由配置檔案或命令面板切換。
+	selected            map[string]bool         // 列表中已選取的筆記路徑，批次動作的對象。
+	visual              bool                    // 是否正在以 V 選取範圍。
+	visualAnchor        string                  // 範圍選取起點的筆記路徑。
+	bulk                bulkAction              // 進行中等待輸入或確認的批次動作。
+	bulkInput           textinput.Model         // 批次動作輸入標籤或資料夾的輸入框。
+	clipboard           clipboard.Mode          // 複製與貼上剪貼簿的方式，來自配置檔案的 clipboard。
+	yanking             bool                    // 是否正在等待選擇要複製的內容。
 }
 
 // InitialModel 函數返回一個初始化的 model 實例。
 // 它是 TUI 應用程式的起始狀態。
 func InitialModel() model {
-	notes, err := storage.ListNotes()
+	m := model{
+		currentView:  listView,
+		inputArea:    NewInputArea(),
+		keys:         defaultKeyMap(),
+		help:         help.New(),
+		filterInput:  newFilterInput(),
+		paletteInput: newPaletteInput(),
+		bulkInput:    newBulkInput(),
+		titleInput:   newFormInput(i18n.T("form.title")),
+		tagsInput:    newFormInput(i18n.T("form.tags")),
+		splitPane:    true,
+		preview:      &previewCache{},
+		spinner:      newSpinner(),
+		views:        listing.Views{},
+	}
+	// AI 心智註解: 配置錯誤不中斷程式，改用預設值並在狀態列警告，錯誤紀錄中也能查到。
+	var warnings []tea.Cmd
+	cfg, err := config.Load()
 	if err != nil {
-		return model{
-			currentView:  listView,
-			errorMessage: fmt.Sprintf("Failed to load notes: %v", err),
-			inputArea:    NewInputArea(),
-		}
+		warnings = append(warnings, m.setStatus(statusWarning, i18n.T("config.load_failed"), err))
+	}
+	m.editor = cfg.Editor
+	if m.keys, err = newKeyMap(cfg.Keys); err != nil {
+		warnings = append(warnings, m.setStatus(statusWarning, i18n.T("config.keys_invalid"), err))
 	}
-	return model{
-		notes:       notes,
-		currentView: listView,
-		inputArea:   NewInputArea(),
+	m.themeName, m.customThemes = cfg.Theme, cfg.Themes
+	m.vaults = cfg.Vaults
+	if m.styles, err = loadStyles(cfg.Theme, cfg.Themes); err != nil {
+		m.themeName = themeAuto
+		warnings = append(warnings, m.setStatus(statusWarning, i18n.T("config.theme_invalid"), themeAuto, err))
 	}
+	m.inputArea.SetStyles(m.styles.focusedPrompt, m.styles.inputCursor)
+	switch cfg.Layout {
+	case "", layoutSplit:
+	case layoutSingle:
+		m.splitPane = false
+	default:
+		warnings = append(warnings, m.setStatus(statusWarning, i18n.T("config.layout_invalid"), cfg.Layout, layoutSplit, layoutSingle, layoutSplit))
+	}
+	if m.clipboard, err = clipboard.ParseMode(cfg.Clipboard); err != nil {
+		warnings = append(warnings, m.setStatus(statusWarning, i18n.T("config.clipboard_invalid"), cfg.Clipboard, clipboard.ModeAuto))
+	}
+	warnings = append(warnings, m.loadOrder(cfg)...)
+	if m.mouse = cfg.MouseEnabled(); m.mouse {
+		warnings = append(warnings, mouseCmd(true))
+	}
+	// AI 心智註解: Init 以值接收者呼叫，無法記錄讀取狀態，因此在此建立指令並由 Init 返回。
+	m.startup = tea.Batch(append(warnings, m.loadNotes(), loadDrafts(true))...)
+	return m
 }
 
 // Init 函數在 TUI 應用程式啟動時被呼叫。
-// 它返回一個 tea.Cmd，用於執行初始操作，例如載入資料。
+// 它返回在背景載入筆記列表的指令。
 func (m model) Init() tea.Cmd {
-	return nil
+	return m.startup
 }
 
 // Update 函數處理傳入的訊息並更新 model 的狀態。
 // 它是 TUI 應用程式的核心邏輯。
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if m, cmd, handled := m.updateLoad(msg); handled {
+		return m, cmd
+	}
+	if m, cmd, handled := m.updateDraftMsg(msg); handled {
+		return m, cmd
+	}
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "ctrl+c", "q":
+		// AI 心智註解: 大量貼上的詢問優先處理，避免 's'、'i' 等按鍵落入輸入區或觸發其他快捷鍵。
+		if m.currentView == createView && m.pendingPaste != "" {
+			return m.handlePastePrompt(msg)
+		}
+		if key.Matches(msg, m.keys.ForceQuit) {
+			// AI 心智註解: 從建立視圖退出前先寫入草稿，下次啟動時可還原。
+			if m.currentView == createView {
+				return m, m.saveDraftAndQuit()
+			}
 			return m, tea.Quit
+		}
+		// AI 心智註解: 複製提示只等待一個按鍵，選擇內容或取消後才回到原本的按鍵處理。
+		if m.yanking {
+			return m.handleYankPrompt(msg)
+		}
+		// AI 心智註解: 建立視圖中的一般字元都屬於輸入內容，不能被 'q' 等單鍵快捷鍵攔截。
+		if m.currentView == createView {
+			return m.updateCreateView(msg)
+		}
+		if m.currentView == errorLogView {
+			return m.updateErrorLog(msg)
+		}
+		if m.currentView == draftsView {
+			return m.updateDrafts(msg)
+		}
+		// AI 心智註解: 讀取進行中時返回鍵先取消讀取，之後抵達的結果會因序號不符而被丟棄。
+		if key.Matches(msg, m.keys.Back) && m.cancelLoading() {
+			return m, m.setStatus(statusInfo, "%s", i18n.T("load.canceled"))
+		}
+		if m.currentView == listView && m.bulk != bulkNone {
+			return m.updateBulk(msg)
+		}
+		if m.currentView == listView && m.filtering {
+			return m.updateFilter(msg)
+		}
+		if m.currentView == detailView {
+			return m.updateDetail(msg)
+		}
+		if m.currentView == paletteView {
+			return m.updatePalette(msg)
+		}
+		if m.currentView == listView {
+			if key.Matches(msg, m.keys.Palette) {
+				return m.openPalette()
+			}
+			if m, cmd, handled := m.runCommandKey(msg); handled {
+				return m, cmd
+			}
+		}
 
-		case "up", "k":
+		switch {
+		case key.Matches(msg, m.keys.Quit):
+			return m, tea.Quit
+
+		case key.Matches(msg, m.keys.Help):
+			m.help.ShowAll = !m.help.ShowAll
+
+		case key.Matches(msg, m.keys.Up):
 			if m.currentView == listView {
 				if m.cursor > 0 {
 					m.cursor--
 				}
+			} else if m.currentView == tasksView {
+				if m.taskCursor > 0 {
+					m.taskCursor--
+				}
 			}
 
-		case "down", "j":
+		case key.Matches(msg, m.keys.Down):
 			if m.currentView == listView {
-				if m.cursor < len(m.notes)-1 {
+				if m.cursor < len(m.items)-1 {
 					m.cursor++
 				}
-			}
-
-		case "enter":
-			if m.currentView == listView && len(m.notes) > 0 {
-				selectedTitle := m.notes[m.cursor]
-				content, err := storage.ReadNote(selectedTitle)
-				if err != nil {
-					m.errorMessage = fmt.Sprintf("Failed to read note: %v", err)
-				} else {
-					m.selectedNoteContent = content
-					m.currentView = detailView
+			} else if m.currentView == tasksView {
+				if m.taskCursor < len(m.tasks)-1 {
+					m.taskCursor++
 				}
 			}
-		case "esc":
-			if m.currentView == detailView {
-				m.currentView = listView
-				m.selectedNoteContent = ""
-			} else if m.currentView == createView {
+
+		case key.Matches(msg, m.keys.Back):
+			if m.currentView == tasksView {
 				m.currentView = listView
+			} else if m.currentView == listView && m.visual {
+				// AI 心智註解: 返回鍵依序取消範圍選取、清除選取，最後才清除篩選。
+				m.visual = false
+			} else if m.currentView == listView && len(m.selected) > 0 {
+				m = m.clearSelection()
+			} else if m.currentView == listView && m.filterInput.Value() != "" {
+				m = m.clearFilter()
 			}
-		case "n": // New note
+
+		case key.Matches(msg, m.keys.Jump):
 			if m.currentView == listView {
-				m.currentView = createView
-				m.newNoteTitle = ""
-				m.newNoteContent = ""
-				m.inputArea = NewInputArea()
-				// AI 心智註解: 及早返回以阻斷當前鍵入事件落入輸入區，避免殘留字元。
-				return m, nil
+				return m.openPinned(msg.String())
+			}
+
+		case key.Matches(msg, m.keys.ErrorLog):
+			return m.openErrorLog(), nil
+
+		case key.Matches(msg, m.keys.ToggleTask):
+			if m.currentView == tasksView {
+				return m.toggleSelectedTask()
 			}
 		}
 
-		if m.currentView == createView {
-			newIA, cmd := m.inputArea.Update(msg)
-			m.inputArea = newIA.(InputArea)
-			return m, cmd
+	case editorFinishedMsg:
+		if msg.err != nil {
+			return m, m.setStatus(statusError, i18n.T("error.editor"), msg.err)
 		}
+		// AI 心智註解: 重新解析使用者編輯後的檔案，front matter 損毀時進入錯誤視圖而非覆寫檔案。
+		return m, reloadEdited(msg.path)
+
+	case tea.MouseMsg:
+		if m.mouse {
+			return m.updateMouse(msg)
+		}
+
+	case linkOpenedMsg:
+		if msg.err != nil {
+			return m, m.setStatus(statusError, i18n.T("error.open_link"), msg.url, msg.err)
+		}
+		return m, m.setStatus(statusInfo, i18n.T("mouse.link_opened"), msg.url)
+
+	case clearStatusMsg:
+		m.clearStatus(msg.id)
+
+	case largePasteMsg:
+		m.pendingPaste = msg.text
+		m.layout()
 
 	case tea.WindowSizeMsg:
-		// 處理視窗大小調整事件。
+		m.width, m.height = msg.Width, msg.Height
+		m.help.Width = msg.Width
+		m.layout()
+		if m.currentView == detailView {
+			m.layoutDetail()
+		}
 
 	case SubmitMsg:
-		lines := strings.Split(msg.Text, "\n")
-		if len(lines) == 0 {
-			lines = []string{""}
-		}
-		title := strings.TrimSpace(lines[0])
-		// AI 心智註解: 保留使用者原始內容，不裁剪前後空白，只做換行拆分後重組。
-		content := strings.Join(lines[1:], "\n")
-		if title == "" {
-			m.errorMessage = "筆記標題不能為空"
-			return m, nil
-		}
-		n := note.NewNote(title, content, nil)
-		err := storage.SaveNote(n)
-		if err != nil {
-			m.errorMessage = fmt.Sprintf("儲存筆記失敗: %v", err)
-		} else {
-			m.notes, err = storage.ListNotes()
-			if err != nil {
-				m.errorMessage = fmt.Sprintf("重新載入筆記失敗: %v", err)
-			} else {
-				m.currentView = listView
-				m.inputArea = NewInputArea()
-			}
-		}
+		// AI 心智註解: 內容欄位送出時帶來內容，標題與標籤從表單的其他欄位取得。
+		return m.submitForm(msg.Text)
 	}
 
 	return m, nil
 }
 
+// updateCreateView 處理建立視圖中的按鍵：返回與捲動歷史之外的按鍵都交給表單。
+func (m model) updateCreateView(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Back):
+		return m.leaveComposer()
+	case key.Matches(msg, m.keys.PageUp):
+		m.historyView.PageUp()
+		return m, nil
+	case key.Matches(msg, m.keys.PageDown):
+		m.historyView.PageDown()
+		return m, nil
+	case key.Matches(msg, m.keys.PasteClipboard):
+		return m, m.pasteClipboard()
+	}
+	m, cmd := m.updateForm(msg)
+	// AI 心智註解: 表單可能因換行、補全候選或驗證錯誤而長高，需重新分配歷史區域的高度。
+	m.layout()
+	return m, cmd
+}
+
+// loadHistory 以既有筆記（依建立時間排序）初始化歷史對話區域。
+// 同一次執行中已載入過時保留既有訊息，包含系統回應。
+func (m *model) loadHistory() {
+	m.layout()
+	if m.history != nil {
+		return
+	}
+	notes := slices.Clone(m.notes)
+	slices.SortFunc(notes, func(a, b *note.Note) int { return a.CreatedAt.Compare(b.CreatedAt) })
+	m.history = []historyEntry{}
+	for _, n := range notes {
+		m.history = append(m.history, noteEntry(n))
+	}
+	m.historyView.SetContent(renderHistory(m.history, m.historyView.Width, m.styles.history))
+	m.historyView.GotoBottom()
+}
+
+// openEditor 返回一個暫停 TUI 並以外部編輯器開啟指定筆記的指令。
+func (m model) openEditor(n *note.Note) tea.Cmd {
+	path := n.Path
+	cmd, err := editor.Command(m.editor, path)
+	if err != nil {
+		return func() tea.Msg { return editorFinishedMsg{path: path, err: err} }
+	}
+	// AI 心智註解: tea.ExecProcess 會暫停 BubbleTea 程式並把終端交給編輯器，結束後再送回訊息。
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		return editorFinishedMsg{path: path, err: err}
+	})
+}
+
 // View 函數根據 model 的當前狀態渲染 TUI 介面。
 // 它返回一個字串，代表要顯示在終端上的內容。
 func (m model) View() string {
-	// 如果有錯誤訊息，則顯示錯誤訊息並提示使用者退出。
-	if m.errorMessage != "" {
-		return fmt.Sprintf("錯誤: %s\n按下 q 鍵退出。", m.errorMessage)
-	}
-
 	// 根據當前視圖狀態渲染不同的介面。
 	switch m.currentView {
 	case listView:
-		s := "您的筆記:\n\n"
-
-		// 如果沒有筆記，則提示使用者建立新筆記。
-		if len(m.notes) == 0 {
-			s += "沒有找到筆記。按下 'n' 鍵建立新筆記。\n"
-		} else {
-			// 遍歷筆記列表，顯示每個筆記的標題，並標記當前選中的筆記。
-			for i, note := range m.notes {
-				cursor := " "
-				if m.cursor == i {
-					cursor = ">"
-				}
-				s += fmt.Sprintf("%s %s\n", cursor, note)
-			}
-		}
-		s += "\n按下 'n' 鍵建立新筆記，'enter' 鍵查看，'q' 鍵退出。\n"
-		return s
+		return m.listViewString()
 
 	case detailView:
 		// 顯示選中筆記的內容。
-		s := fmt.Sprintf("筆記內容:\n\n%s\n\n按下 'esc' 鍵返回，'q' 鍵退出。\n", m.selectedNoteContent)
-		return s
+		return m.detailViewString()
 
 	case createView:
 		// 顯示建立新筆記的介面。
-		s := "建立新筆記:\n\n" + m.inputArea.View() + "\n\n按下 'esc' 鍵取消，'q' 鍵退出。\n"
-		return s
+		return m.createViewString()
+
+	case tasksView:
+		return m.tasksViewString()
+
+	case errorLogView:
+		return m.errorLogViewString()
+
+	case draftsView:
+		return m.draftsViewString()
+
+	case paletteView:
+		return m.paletteViewString()
 	}
 	return ""
 }
@@ -6,9 +6,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
 	"github.com/wtg42/ora-ora-ora/internal/note"
 	"github.com/wtg42/ora-ora-ora/internal/storage"
 )
@@ -35,7 +37,60 @@ func writeTestNote(title, content string) error {
 		CreatedAt: time.Now(),
 	}
 	return storage.SaveNote(n)
-} // TestInitialModel 測試 InitialModel 函數是否能正確初始化模型。
+}
+
+// noteTitles 是一個輔助函數，返回筆記的標題列表。
+func noteTitles(notes []*note.Note) []string {
+	titles := make([]string, len(notes))
+	for i, n := range notes {
+		titles[i] = n.Title
+	}
+	return titles
+}
+
+// cmdTimeout 是 runCmd 等待單一指令的時間，超過時視為尚未觸發的計時指令。
+const cmdTimeout = 100 * time.Millisecond
+
+// runCmd 執行指令並將產生的訊息送回 model，直到沒有後續指令為止。
+// AI 心智註解: 狀態列到期、游標閃爍等計時指令會真的等待，逾時即略過；spinner 的計時訊息也不送回，避免無限循環。
+func runCmd(m model, cmd tea.Cmd) model {
+	if cmd == nil {
+		return m
+	}
+	done := make(chan tea.Msg, 1)
+	go func() { done <- cmd() }()
+	var msg tea.Msg
+	select {
+	case msg = <-done:
+	case <-time.After(cmdTimeout):
+		return m
+	}
+	switch msg := msg.(type) {
+	case nil, spinner.TickMsg:
+	case tea.BatchMsg:
+		for _, c := range msg {
+			m = runCmd(m, c)
+		}
+	default:
+		updatedModel, next := m.Update(msg)
+		m = runCmd(updatedModel.(model), next)
+	}
+	return m
+}
+
+// update 送出訊息並執行所有後續的背景指令，返回最終的 model。
+func update(m model, msg tea.Msg) model {
+	updatedModel, cmd := m.Update(msg)
+	return runCmd(updatedModel.(model), cmd)
+}
+
+// loadedModel 返回已完成初始載入的 model。
+func loadedModel() model {
+	m := InitialModel()
+	return runCmd(m, m.Init())
+}
+
+// TestInitialModel 測試 InitialModel 不在建構時讀取筆記，而是由 Init 在背景載入。
 func TestInitialModel(t *testing.T) {
 	_, teardown := setupTestDataDir(t)
 	defer teardown()
@@ -45,10 +100,40 @@ func TestInitialModel(t *testing.T) {
 	require.NoError(t, writeTestNote("NoteB", "Content B"))
 
 	m := InitialModel()
-
 	assert.Equal(t, listView, m.currentView)
-	assert.ElementsMatch(t, []string{"NoteA", "NoteB"}, m.notes)
-	assert.Empty(t, m.errorMessage)
+	assert.Empty(t, m.notes)
+	assert.True(t, m.busy())
+	assert.Contains(t, m.View(), "載入筆記")
+
+	cmd := m.Init()
+	require.NotNil(t, cmd)
+	m = runCmd(m, cmd)
+	assert.ElementsMatch(t, []string{"NoteA", "NoteB"}, noteTitles(m.notes))
+	assert.Empty(t, m.errorLog)
+	assert.False(t, m.busy())
+	assert.NotContains(t, m.View(), "載入筆記")
+}
+
+// TestView_EnglishLocale 測試切換到英文語系後，視圖、說明列與 storage 的驗證錯誤都以英文顯示。
+func TestView_EnglishLocale(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	old := i18n.Current()
+	i18n.Set(i18n.En)
+	t.Cleanup(func() { i18n.Set(old) })
+
+	m := loadedModel()
+	view := m.View()
+	assert.Contains(t, view, "Your notes:")
+	assert.Contains(t, view, "No notes found. Press 'n' to create a note.")
+	assert.Contains(t, view, "n new note")
+
+	m = openForm(t, m)
+	m = pressKey(m, typeText("a/b"))
+	view = m.View()
+	assert.Contains(t, view, "Title: a/b")
+	assert.Contains(t, view, "title contains characters not allowed in file names: a/b")
+	assert.NotContains(t, view, "標題")
 }
 
 // TestUpdate_ListViewNavigation 測試在列表視圖中的導航功能。
@@ -59,7 +144,7 @@ func TestUpdate_ListViewNavigation(t *testing.T) {
 	require.NoError(t, writeTestNote("Note1", "Content 1"))
 	require.NoError(t, writeTestNote("Note2", "Content 2"))
 
-	m := InitialModel()
+	m := loadedModel()
 	assert.Equal(t, 0, m.cursor)
 
 	// Move down
@@ -90,18 +175,17 @@ func TestUpdate_ViewNoteContent(t *testing.T) {
 
 	require.NoError(t, writeTestNote("MyNote", "This is the content of MyNote."))
 
-	m := InitialModel()
+	m := loadedModel()
 	assert.Equal(t, listView, m.currentView)
 
 	// Press Enter to view the note
-	updatedModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
-	m = updatedModel.(model)
+	m = update(m, tea.KeyMsg{Type: tea.KeyEnter})
 
 	assert.Equal(t, detailView, m.currentView)
 	assert.Equal(t, "This is the content of MyNote.", m.selectedNoteContent)
 
 	// Press Esc to go back to list view
-	updatedModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	updatedModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
 	m = updatedModel.(model)
 	assert.Equal(t, listView, m.currentView)
 	assert.Empty(t, m.selectedNoteContent)
@@ -112,7 +196,7 @@ func TestUpdate_CreateNewNote(t *testing.T) {
 	_, teardown := setupTestDataDir(t)
 	defer teardown()
 
-	m := InitialModel()
+	m := loadedModel()
 	assert.Equal(t, listView, m.currentView)
 
 	// Press 'n' to go to create view
@@ -130,7 +214,7 @@ func TestUpdate_CreateNewNote(t *testing.T) {
 
 func TestCreateViewInputAreaStartsEmpty(t *testing.T) {
 	// AI 心智註解: 確保切換建立視圖後輸入區會回到乾淨狀態，避免遺留觸發鍵。
-	m := InitialModel()
+	m := loadedModel()
 
 	updatedModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
 	m = updatedModel.(model)
@@ -141,7 +225,7 @@ func TestCreateViewInputAreaStartsEmpty(t *testing.T) {
 
 // TestUpdate_Quit 測試退出應用程式的功能。
 func TestUpdate_Quit(t *testing.T) {
-	m := InitialModel()
+	m := loadedModel()
 	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
 	assert.NotNil(t, cmd)
 
@@ -151,7 +235,7 @@ func TestUpdate_Quit(t *testing.T) {
 
 // TestUpdate_BasicKeyInput 測試基本鍵入事件處理，確保 Update 不 panic 並返回有效模型。
 func TestUpdate_BasicKeyInput(t *testing.T) {
-	m := InitialModel()
+	m := loadedModel()
 
 	// 測試基本鍵入事件 'n'
 	updatedModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
@@ -164,17 +248,64 @@ func TestSubmitPreservesWhitespace(t *testing.T) {
 	_, teardown := setupTestDataDir(t)
 	defer teardown()
 
-	m := InitialModel()
+	m := loadedModel()
 	m.currentView = createView
-	m.inputArea = NewInputArea()
+	m.titleInput.SetValue("Title")
 
-	input := "Title\n  leading\n\ntrailing  "
-	updatedModel, _ := m.Update(SubmitMsg{Text: input})
-	m = updatedModel.(model)
+	m = update(m, SubmitMsg{Text: "  leading\n\ntrailing  "})
 
-	require.Empty(t, m.errorMessage)
+	require.Empty(t, m.errorLog)
 
 	content, err := storage.ReadNote("Title")
 	require.NoError(t, err)
 	assert.Equal(t, "  leading\n\ntrailing  ", content)
 }
+
+// TestUpdate_EditKeyOpensEditor 測試在列表視圖按下 'e' 會返回開啟編輯器的指令。
+func TestUpdate_EditKeyOpensEditor(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	require.NoError(t, writeTestNote("EditMe", "Content"))
+
+	m := loadedModel()
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	assert.NotNil(t, cmd)
+}
+
+// TestUpdate_EditorFinished 測試編輯器結束後重新解析筆記並更新列表。
+func TestUpdate_EditorFinished(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	require.NoError(t, writeTestNote("Before", "Old content"))
+	path, err := storage.FindNotePath("Before")
+	require.NoError(t, err)
+
+	m := loadedModel()
+	edited := "---\ntitle: \"After\"\ncreated_at: \"2024-01-01T00:00:00Z\"\n---\n\nNew content"
+	require.NoError(t, os.WriteFile(path, []byte(edited), 0644))
+
+	m = update(m, editorFinishedMsg{path: path})
+
+	assert.Empty(t, m.errorLog)
+	assert.Equal(t, []string{"After"}, noteTitles(m.notes))
+}
+
+// TestUpdate_EditorFinishedBrokenFrontMatter 測試使用者破壞 front matter 時顯示錯誤。
+func TestUpdate_EditorFinishedBrokenFrontMatter(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	require.NoError(t, writeTestNote("Broken", "Content"))
+	path, err := storage.FindNotePath("Broken")
+	require.NoError(t, err)
+
+	m := loadedModel()
+	require.NoError(t, os.WriteFile(path, []byte("no front matter at all"), 0644))
+
+	m = update(m, editorFinishedMsg{path: path})
+
+	assert.Contains(t, m.statusView(), "編輯後的筆記無效")
+	assert.Equal(t, listView, m.currentView, "錯誤不應取代目前的視圖")
+}
@@ -0,0 +1,212 @@
+// Package tui 提供了終端使用者介面 (TUI) 的實現。
+package tui
+
+import (
+	"regexp"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/mattn/go-runewidth"
+	"github.com/wtg42/ora-ora-ora/internal/editor"
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+)
+
+// wheelDelta 是滑鼠滾輪每次捲動詳細視圖與歷史區域的行數。
+const wheelDelta = 3
+
+var (
+	// wikiLinkPattern 匹配詳細視圖中的內部連結，格式與 note.Link 相同，例如 [[筆記標題]]。
+	wikiLinkPattern = regexp.MustCompile(`\[\[([^\[\]]+)\]\]`)
+	// urlPattern 匹配詳細視圖中的網址；不含括號與引號，原始碼模式中 [文字](網址) 的右括號不會被算進網址。
+	urlPattern = regexp.MustCompile(`https?://[^\s<>()\[\]"'` + "`" + `]+`)
+)
+
+// startBrowser 以瀏覽器開啟網址，測試時可替換。
+var startBrowser = func(url string) error {
+	return editor.Browser(url).Run()
+}
+
+// linkOpenedMsg 訊息表示詳細視圖中點擊的網址已交給瀏覽器開啟。
+type linkOpenedMsg struct {
+	url string
+	err error
+}
+
+// mouseCmd 返回依設定啟用或停用滑鼠事件的指令。
+// AI 心智註解: 使用 cell motion 模式只回報按下、放開與拖曳，不會在滑鼠移動時持續送出事件。
+func mouseCmd(enabled bool) tea.Cmd {
+	if enabled {
+		return tea.EnableMouseCellMotion
+	}
+	return tea.DisableMouse
+}
+
+// toggleMouse 在本次執行中切換滑鼠模式；停用後可直接以終端選取文字。
+func (m model) toggleMouse() (tea.Model, tea.Cmd) {
+	m.mouse = !m.mouse
+	status := i18n.T("mouse.disabled")
+	if m.mouse {
+		status = i18n.T("mouse.enabled")
+	}
+	return m, tea.Batch(mouseCmd(m.mouse), m.setStatus(statusInfo, "%s", status))
+}
+
+// isClick 判斷滑鼠事件是否為按下左鍵。
+func isClick(msg tea.MouseMsg) bool {
+	return msg.Action == tea.MouseActionPress && msg.Button == tea.MouseButtonLeft
+}
+
+// updateMouse 依目前視圖處理滑鼠事件；所有操作都有對應的按鍵，滑鼠只是捷徑。
+func (m model) updateMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	// AI 心智註解: 點擊可能開啟其他筆記，先取消等待中的複製提示，避免下一個按鍵複製到非預期的筆記。
+	if isClick(msg) {
+		m.yanking = false
+	}
+	switch m.currentView {
+	case listView:
+		return m.mouseList(msg)
+	case detailView:
+		return m.mouseDetail(msg)
+	case createView:
+		return m.mouseCreate(msg)
+	}
+	return m, nil
+}
+
+// mouseList 處理列表視圖的滑鼠事件：滾輪移動游標，點擊選取筆記，點擊已選取的筆記則開啟。
+func (m model) mouseList(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case msg.Button == tea.MouseButtonWheelUp:
+		m.cursor = max(m.cursor-1, 0)
+		return m, nil
+	case msg.Button == tea.MouseButtonWheelDown:
+		m.cursor = max(min(m.cursor+1, len(m.items)-1), 0)
+		return m, nil
+	case !isClick(msg) || !m.notesLoaded || len(m.items) == 0 || m.bulk != bulkNone:
+		// AI 心智註解: 批次動作等待輸入或確認時點擊不開啟筆記，避免離開列表後提示仍在。
+		return m, nil
+	case m.splitActive() && msg.X >= m.listPaneWidth():
+		// AI 心智註解: 點擊右側預覽窗格不改變選取。
+		return m, nil
+	}
+	header := m.listHeader()
+	row := msg.Y - strings.Count(header, "\n")
+	lines := m.listLines(m.listHeight(header, m.footerView()))
+	if row < 0 || row >= len(lines) || lines[row].item < 0 {
+		return m, nil
+	}
+	if item := lines[row].item; item != m.cursor {
+		m.cursor = item
+		return m, nil
+	}
+	return m, m.openNote(m.selectedNote().Path)
+}
+
+// mouseDetail 處理詳細視圖的滑鼠事件：滾輪捲動內容，點擊 [[標題]] 開啟筆記，點擊網址以瀏覽器開啟。
+func (m model) mouseDetail(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case msg.Button == tea.MouseButtonWheelUp:
+		m.reader.ScrollUp(wheelDelta)
+		return m, nil
+	case msg.Button == tea.MouseButtonWheelDown:
+		m.reader.ScrollDown(wheelDelta)
+		return m, nil
+	case !isClick(msg) || m.searching:
+		return m, nil
+	}
+	// AI 心智註解: viewport 位於一行標題列之下，點擊的行換算為內容中的行號。
+	row := msg.Y - 1
+	line := m.reader.YOffset + row
+	if row < 0 || row >= m.reader.Height || line >= len(m.readerLines) {
+		return m, nil
+	}
+	title, url := linkAt(m.readerLines[line], msg.X)
+	switch {
+	case title != "":
+		for _, n := range m.notes {
+			if n.Title == title {
+				m.selectPath(n.Path)
+				return m, m.openNote(n.Path)
+			}
+		}
+		return m, m.setStatus(statusWarning, i18n.T("mouse.link_not_found"), title)
+	case url != "":
+		return m, func() tea.Msg {
+			return linkOpenedMsg{url: url, err: startBrowser(url)}
+		}
+	}
+	return m, nil
+}
+
+// linkAt 返回去除樣式的行中位於顯示欄位 col 的連結：內部連結返回目標標題，網址返回網址，都不是時返回空字串。
+func linkAt(line string, col int) (title, url string) {
+	hit := func(loc []int) bool {
+		start := ansi.StringWidth(line[:loc[0]])
+		return col >= start && col < start+ansi.StringWidth(line[loc[0]:loc[1]])
+	}
+	for _, loc := range wikiLinkPattern.FindAllStringSubmatchIndex(line, -1) {
+		if hit(loc) {
+			return line[loc[2]:loc[3]], ""
+		}
+	}
+	for _, loc := range urlPattern.FindAllStringIndex(line, -1) {
+		if hit(loc) {
+			return "", line[loc[0]:loc[1]]
+		}
+	}
+	return "", ""
+}
+
+// mouseCreate 處理建立視圖的滑鼠事件：滾輪捲動歷史區域，點擊表單欄位取得焦點並把游標移到點擊的位置。
+func (m model) mouseCreate(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case m.pendingPaste != "":
+		// AI 心智註解: 大量貼上的詢問必須以按鍵回答，避免點擊後內容落在非預期的欄位。
+		return m, nil
+	case msg.Button == tea.MouseButtonWheelUp:
+		m.historyView.ScrollUp(wheelDelta)
+		return m, nil
+	case msg.Button == tea.MouseButtonWheelDown:
+		m.historyView.ScrollDown(wheelDelta)
+		return m, nil
+	case !isClick(msg):
+		return m, nil
+	}
+	// AI 心智註解: 表單位於一行標題列與歷史區域之下；標題欄位下方可能有驗證錯誤，內容欄位固定在表單最後。
+	row := msg.Y - 1 - m.historyView.Height
+	tagsRow := 1
+	if m.fieldError(fieldTitle) != "" {
+		tagsRow++
+	}
+	bodyRow := lipgloss.Height(m.formView()) - lipgloss.Height(m.inputArea.View())
+	var cmd tea.Cmd
+	switch {
+	case row == 0:
+		cmd = m.focusField(fieldTitle)
+		m.titleInput.SetCursor(runeIndexAt(m.titleInput.Value(), msg.X-lipgloss.Width(m.titleInput.Prompt)))
+	case row == tagsRow:
+		cmd = m.focusField(fieldTags)
+		m.tagsInput.SetCursor(runeIndexAt(m.tagsInput.Value(), msg.X-lipgloss.Width(m.tagsInput.Prompt)))
+	case row >= bodyRow:
+		cmd = m.focusField(fieldBody)
+		m.inputArea.MoveCursorTo(row-bodyRow, msg.X)
+	default:
+		return m, nil
+	}
+	m.layout()
+	return m, cmd
+}
+
+// runeIndexAt 返回字串中顯示欄位 col 所在的 rune 索引，超出字串時返回字串的 rune 數。
+func runeIndexAt(s string, col int) int {
+	w := 0
+	for i, r := range []rune(s) {
+		w += runewidth.RuneWidth(r)
+		if w > col {
+			return i
+		}
+	}
+	return len([]rune(s))
+}
@@ -0,0 +1,180 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// click 送出在 (x, y) 按下滑鼠左鍵的事件並執行後續指令。
+func click(m model, x, y int) model {
+	return update(m, tea.MouseMsg{X: x, Y: y, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft})
+}
+
+// wheel 送出滑鼠滾輪事件。
+func wheel(m model, button tea.MouseButton) model {
+	return update(m, tea.MouseMsg{Action: tea.MouseActionPress, Button: button})
+}
+
+// TestMouse_ListClickSelectsAndOpens 測試點擊列表項目選取筆記，再點一次開啟；組名與空白處的點擊被忽略。
+func TestMouse_ListClickSelectsAndOpens(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	seedOrderNotes(t)
+
+	m := update(loadedModel(), tea.WindowSizeMsg{Width: 60, Height: 20})
+	require.True(t, m.mouse)
+	// 標題列與空行之後是第一個項目。
+	m = click(m, 4, 3)
+	assert.Equal(t, "Alpha", m.selectedNote().Title)
+	m = click(m, 4, 10)
+	assert.Equal(t, "Alpha", m.selectedNote().Title)
+
+	m = wheel(m, tea.MouseButtonWheelDown)
+	assert.Equal(t, "gamma", m.selectedNote().Title)
+	m = wheel(m, tea.MouseButtonWheelUp)
+	m = wheel(m, tea.MouseButtonWheelUp)
+	assert.Equal(t, "beta", m.selectedNote().Title)
+
+	m = pressRune(pressRune(m, 'g'), 'g')
+	require.Equal(t, []string{"gamma", "beta", "gamma", "Alpha"}, itemTitles(m.items))
+	m = click(m, 4, 2)
+	assert.Equal(t, "beta", m.selectedNote().Title, "點擊組名不改變選取")
+	m = click(m, 4, 3)
+	m = click(m, 4, 3)
+	require.Equal(t, detailView, m.currentView)
+	assert.Equal(t, "gamma", m.detailNote.Title)
+}
+
+// TestMouse_SplitPreviewIgnored 測試雙欄版面中點擊右側預覽窗格不改變選取。
+func TestMouse_SplitPreviewIgnored(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	seedOrderNotes(t)
+
+	m := update(loadedModel(), tea.WindowSizeMsg{Width: 100, Height: 20})
+	require.True(t, m.splitActive())
+	m = click(m, m.listPaneWidth()+5, 3)
+	assert.Equal(t, "beta", m.selectedNote().Title)
+	m = click(m, 2, 4)
+	assert.Equal(t, "gamma", m.selectedNote().Title)
+}
+
+// findColumn 返回詳細視圖中含有 text 的行在畫面上的位置。
+func findColumn(t *testing.T, m model, text string) (x, y int) {
+	for i, line := range m.readerLines {
+		if j := strings.Index(line, text); j >= 0 {
+			return ansi.StringWidth(line[:j]), i - m.reader.YOffset + 1
+		}
+	}
+	require.Failf(t, "找不到文字", "%q", text)
+	return 0, 0
+}
+
+// TestMouse_DetailLinks 測試詳細視圖中點擊 [[標題]] 開啟筆記、點擊網址交給瀏覽器，滾輪捲動內容。
+func TestMouse_DetailLinks(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	require.NoError(t, writeTestNote("Target", "target body"))
+	var opened []string
+	old := startBrowser
+	startBrowser = func(url string) error {
+		opened = append(opened, url)
+		return nil
+	}
+	defer func() { startBrowser = old }()
+
+	m := openTestDetail(t, "見 [[Target]] 與 [[Missing]]\n\n網址 https://example.com/a\n\n"+strings.Repeat("line\n\n", 30))
+
+	x, y := findColumn(t, m, "https://")
+	m = click(m, x+3, y)
+	assert.Equal(t, []string{"https://example.com/a"}, opened)
+	assert.Contains(t, m.detailStatus(), "https://example.com/a")
+
+	x, y = findColumn(t, m, "[[Missing]]")
+	m = click(m, x+2, y)
+	assert.Contains(t, m.detailStatus(), "Missing")
+	assert.Equal(t, "Doc", m.detailNote.Title)
+
+	m = wheel(m, tea.MouseButtonWheelDown)
+	assert.Equal(t, wheelDelta, m.reader.YOffset)
+	m = wheel(m, tea.MouseButtonWheelUp)
+	assert.Equal(t, 0, m.reader.YOffset)
+
+	x, y = findColumn(t, m, "[[Target]]")
+	m = click(m, x, y)
+	require.Equal(t, detailView, m.currentView)
+	assert.Equal(t, "Target", m.detailNote.Title)
+}
+
+// TestLinkAt 測試依顯示欄位找出連結，全形字佔兩欄。
+func TestLinkAt(t *testing.T) {
+	line := "漢字 [[筆記]] (https://example.com)"
+	title, url := linkAt(line, 5)
+	assert.Equal(t, "筆記", title)
+	assert.Empty(t, url)
+	_, url = linkAt(line, 20)
+	assert.Equal(t, "https://example.com", url)
+	title, url = linkAt(line, 0)
+	assert.Empty(t, title)
+	assert.Empty(t, url)
+}
+
+// TestMouse_CreateFocusesClickedField 測試建立視圖中點擊欄位取得焦點，點擊內容時游標移到點擊的位置。
+func TestMouse_CreateFocusesClickedField(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	m := update(loadedModel(), tea.WindowSizeMsg{Width: 60, Height: 20})
+	m = openForm(t, m)
+	m = fillFormFields(m, "title", "work", "hello world")
+	require.Equal(t, fieldBody, m.formFocus)
+
+	formTop := 1 + m.historyView.Height
+	m = click(m, lenPrompt(m.titleInput.Prompt)+2, formTop)
+	assert.Equal(t, fieldTitle, m.formFocus)
+	assert.Equal(t, 2, m.titleInput.Position())
+
+	m = click(m, lenPrompt(m.tagsInput.Prompt), formTop+1)
+	assert.Equal(t, fieldTags, m.formFocus)
+
+	m = click(m, len(promptPrefix)+5, formTop+lenLines(m.formView())-1)
+	assert.Equal(t, fieldBody, m.formFocus)
+	assert.Equal(t, 5, m.inputArea.cursor)
+	m = pressKey(m, typeText(","))
+	assert.Equal(t, "hello, world", m.inputArea.Text())
+}
+
+// lenPrompt 返回提示文字的顯示寬度。
+func lenPrompt(prompt string) int {
+	return ansi.StringWidth(prompt)
+}
+
+// lenLines 返回字串的行數。
+func lenLines(s string) int {
+	return strings.Count(s, "\n") + 1
+}
+
+// TestMouse_Toggle 測試配置檔案停用滑鼠時忽略滑鼠事件，並可從命令面板重新啟用。
+func TestMouse_Toggle(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	seedOrderNotes(t)
+	setupTestConfig(t, "mouse = false\n")
+
+	m := update(loadedModel(), tea.WindowSizeMsg{Width: 60, Height: 20})
+	require.False(t, m.mouse)
+	m = click(m, 4, 3)
+	assert.Equal(t, "beta", m.selectedNote().Title)
+
+	m = openTestPalette(t, m, "mouse")
+	m = update(m, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.True(t, m.mouse)
+	assert.Contains(t, m.View(), "已啟用滑鼠")
+	m = click(m, 4, 3)
+	assert.Equal(t, "Alpha", m.selectedNote().Title)
+}
@@ -0,0 +1,156 @@
+// Package tui 提供了終端使用者介面 (TUI) 的實現。
+package tui
+
+import (
+	"slices"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wtg42/ora-ora-ora/internal/config"
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+	"github.com/wtg42/ora-ora-ora/internal/listing"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+)
+
+// viewRecordedMsg 訊息表示筆記的查看時間已在背景寫入查看紀錄。
+type viewRecordedMsg struct {
+	err error
+}
+
+// loadOrder 依配置檔案的 sort 與 group 設定列表的初始排序與分組，設定無效時返回警告訊息的指令。
+func (m *model) loadOrder(cfg config.Config) []tea.Cmd {
+	var warnings []tea.Cmd
+	m.order = listing.Default()
+	if cfg.Sort != "" {
+		if key, desc, ok := listing.ParseSort(cfg.Sort); ok {
+			m.order.Key, m.order.Desc = key, desc
+		} else {
+			warnings = append(warnings, m.setStatus(statusWarning, i18n.T("config.sort_invalid"), cfg.Sort, m.order.SortSpec()))
+		}
+	}
+	if g, ok := listing.ParseGroup(cfg.Group); ok {
+		m.order.Group = g
+	} else {
+		warnings = append(warnings, m.setStatus(statusWarning, i18n.T("config.group_invalid"), cfg.Group, listing.GroupNone))
+	}
+	return warnings
+}
+
+// cycleSort 切換到下一個排序鍵，並使用該鍵的預設方向。
+func (m model) cycleSort() (tea.Model, tea.Cmd) {
+	i := slices.Index(listing.Keys, m.order.Key)
+	m.order.Key = listing.Keys[(i+1)%len(listing.Keys)]
+	m.order.Desc = m.order.Key.DefaultDesc()
+	return m, m.applyFilter()
+}
+
+// reverseSort 反轉目前的排序方向。
+func (m model) reverseSort() (tea.Model, tea.Cmd) {
+	m.order.Desc = !m.order.Desc
+	return m, m.applyFilter()
+}
+
+// cycleGroup 切換到下一個分組方式。
+func (m model) cycleGroup() (tea.Model, tea.Cmd) {
+	i := slices.Index(listing.Groupings, m.order.Group)
+	m.order.Group = listing.Groupings[(i+1)%len(listing.Groupings)]
+	return m, m.applyFilter()
+}
+
+// groupItems 依目前的分組方式重新排列列表項目並填入組名，每組內保留原本的順序；釘選的筆記固定在最上方，分組時自成一組。
+// 可對已分組的列表再次呼叫：依標籤分組而重複出現的筆記只保留第一次出現的項目再重新分組。
+func (m model) groupItems(items []listItem) []listItem {
+	byNote := make(map[*note.Note]listItem, len(items))
+	var pinned, notes []*note.Note
+	for _, item := range items {
+		if _, ok := byNote[item.note]; ok {
+			continue
+		}
+		byNote[item.note] = item
+		if item.note.Pinned {
+			pinned = append(pinned, item.note)
+		} else {
+			notes = append(notes, item.note)
+		}
+	}
+	grouped := make([]listItem, 0, len(items))
+	add := func(notes []*note.Note, label string) {
+		for _, n := range notes {
+			item := byNote[n]
+			item.group = label
+			grouped = append(grouped, item)
+		}
+	}
+	if m.order.Group == listing.GroupNone {
+		add(pinned, "")
+		add(notes, "")
+		return grouped
+	}
+	add(pinned, i18n.T("order.pinned"))
+	for _, g := range listing.GroupNotes(notes, m.order, m.views, time.Now()) {
+		add(g.Notes, i18n.GroupLabel(m.order.Group, g.Name))
+	}
+	return grouped
+}
+
+// orderLabel 返回標題列後顯示的排序與分組說明，使用預設排序時返回空字串。
+func (m model) orderLabel() string {
+	if m.order == listing.Default() {
+		return ""
+	}
+	dir := i18n.T("order.asc")
+	if m.order.Desc {
+		dir = i18n.T("order.desc")
+	}
+	label := i18n.T("order.sort_label", i18n.T("order.key."+string(m.order.Key)), dir)
+	if m.order.Group != listing.GroupNone {
+		label += "  " + i18n.T("order.group_label", i18n.T("order.group."+string(m.order.Group)))
+	}
+	return label
+}
+
+// listDate 返回列表日期欄顯示的日期：依排序鍵使用建立、更新或查看時間，未曾查看時返回空字串。
+func (m model) listDate(n *note.Note) string {
+	t := listing.Date(n, m.order.Key, m.views)
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(filterDateLayout)
+}
+
+// mergeViews 合併從查看紀錄檔讀取的時間，保留較新的紀錄。
+// AI 心智註解: 背景寫入查看紀錄與重新載入可能交錯，讀到的檔案可能尚未包含剛查看的筆記，因此不直接取代。
+func (m *model) mergeViews(views listing.Views) {
+	for id, at := range views {
+		if at.After(m.views[id]) {
+			m.views[id] = at
+		}
+	}
+}
+
+// recordView 記下筆記的查看時間，並返回在背景寫入查看紀錄檔的指令。
+// 依最近查看排序時重新排列列表，返回列表時剛查看的筆記即在正確的位置。
+func (m *model) recordView(n *note.Note) tea.Cmd {
+	id, at := n.ID(), time.Now()
+	m.views[id] = at
+	var resort tea.Cmd
+	if m.order.Key == listing.KeyViewed {
+		resort = m.applyFilter()
+	}
+	return tea.Batch(resort, func() tea.Msg {
+		path, err := listing.DefaultViewsPath()
+		if err == nil {
+			err = listing.RecordView(path, id, at)
+		}
+		return viewRecordedMsg{err: err}
+	})
+}
+
+// loadViews 讀取查看紀錄檔。
+func loadViews() (listing.Views, error) {
+	path, err := listing.DefaultViewsPath()
+	if err != nil {
+		return nil, err
+	}
+	return listing.LoadViews(path)
+}
@@ -0,0 +1,124 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtg42/ora-ora-ora/internal/listing"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+)
+
+// seedOrderNotes 建立排序與分組測試用的筆記：建立時間依序遞增，標題與長度各不相同。
+func seedOrderNotes(t *testing.T) {
+	base := time.Now().AddDate(0, 0, -3)
+	for i, n := range []*note.Note{
+		{Title: "beta", Content: "12345", Tags: []string{"work"}},
+		{Title: "Alpha", Content: "1"},
+		{Title: "gamma", Content: "123", Tags: []string{"home", "work"}},
+	} {
+		n.CreatedAt = base.Add(time.Duration(i) * time.Hour)
+		require.NoError(t, storage.SaveNote(n))
+	}
+}
+
+// pressRune 送出單一字元按鍵並執行後續指令。
+func pressRune(m model, r rune) model {
+	return update(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+}
+
+// TestOrder_CycleAndReverse 測試 's' 切換排序鍵、'S' 反轉方向，游標停留在原本選中的筆記。
+func TestOrder_CycleAndReverse(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	seedOrderNotes(t)
+
+	m := loadedModel()
+	assert.Equal(t, []string{"beta", "Alpha", "gamma"}, itemTitles(m.items))
+	assert.NotContains(t, m.View(), "排序：", "預設排序不顯示說明")
+
+	m = pressRune(m, 's')
+	assert.Equal(t, listing.KeyUpdated, m.order.Key)
+	assert.Equal(t, []string{"gamma", "Alpha", "beta"}, itemTitles(m.items), "未編輯過的筆記依建立時間由新到舊")
+	assert.Contains(t, m.View(), "排序：更新時間 ↓")
+
+	m = pressRune(m, 's')
+	assert.Equal(t, []string{"Alpha", "beta", "gamma"}, itemTitles(m.items))
+	assert.Equal(t, "beta", m.selectedNote().Title)
+	m = pressRune(m, 'S')
+	assert.Equal(t, []string{"gamma", "beta", "Alpha"}, itemTitles(m.items))
+	assert.Equal(t, "beta", m.selectedNote().Title)
+}
+
+// TestOrder_GroupByTag 測試 'g' 切換分組，列表顯示組名且有多個標籤的筆記出現在每個標籤下。
+func TestOrder_GroupByTag(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	seedOrderNotes(t)
+
+	m := pressRune(pressRune(loadedModel(), 'g'), 'g')
+	require.Equal(t, listing.GroupTag, m.order.Group)
+	assert.Equal(t, []string{"gamma", "beta", "gamma", "Alpha"}, itemTitles(m.items))
+	view := m.View()
+	assert.Contains(t, view, "分組：標籤")
+	assert.Less(t, strings.Index(view, "#home"), strings.Index(view, "#work"))
+	assert.Less(t, strings.Index(view, "#work"), strings.Index(view, "未加標籤"))
+}
+
+// TestOrder_ConfigAndViewed 測試配置檔案的初始排序，以及查看筆記後依最近查看排序並寫入查看紀錄。
+func TestOrder_ConfigAndViewed(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	seedOrderNotes(t)
+	setupTestConfig(t, "sort = \"viewed\"\ngroup = \"date\"\n")
+
+	m := loadedModel()
+	assert.Equal(t, listing.Options{Key: listing.KeyViewed, Desc: true, Group: listing.GroupDate}, m.order)
+	assert.Contains(t, m.View(), "未曾查看")
+
+	m = pressRune(m, 'j')
+	m = update(m, tea.KeyMsg{Type: tea.KeyEnter})
+	require.Equal(t, detailView, m.currentView)
+	assert.Equal(t, "Alpha", m.items[0].note.Title, "剛查看的筆記排在最前")
+	assert.Equal(t, "Alpha", m.selectedNote().Title)
+
+	path, err := listing.DefaultViewsPath()
+	require.NoError(t, err)
+	views, err := listing.LoadViews(path)
+	require.NoError(t, err)
+	assert.Contains(t, views, m.detailNote.ID())
+
+	m = loadedModel()
+	assert.Equal(t, "Alpha", m.items[0].note.Title, "重新啟動後沿用查看紀錄")
+	assert.Equal(t, "今天", m.items[0].group)
+}
+
+// TestOrder_InvalidConfig 測試無效的排序與分組設定改用預設值並警告。
+func TestOrder_InvalidConfig(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	setupTestConfig(t, "sort = \"name\"\ngroup = \"month\"\n")
+
+	m := loadedModel()
+	assert.Equal(t, listing.Default(), m.order)
+	require.Len(t, m.errorLog, 2)
+	assert.Contains(t, m.errorLog[0].text, "name")
+	assert.Contains(t, m.errorLog[1].text, "month")
+}
+
+// TestListRows_GroupHeadersFitHeight 測試組名佔用的行數不會讓列表超出高度或看不到游標。
+func TestListRows_GroupHeadersFitHeight(t *testing.T) {
+	m := InitialModel()
+	m.order.Group = listing.GroupTag
+	for i := range 6 {
+		m.items = append(m.items, listItem{note: &note.Note{Title: string(rune('a' + i))}, group: string(rune('A' + i))})
+	}
+	m.cursor = 5
+	rows := strings.Split(m.listRows(0, 4, false), "\n")
+	require.Len(t, rows, 4)
+	assert.Equal(t, "> f", rows[len(rows)-1])
+}
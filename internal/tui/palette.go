@@ -0,0 +1,170 @@
+// Package tui 提供了終端使用者介面 (TUI) 的實現。
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sahilm/fuzzy"
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+	"github.com/wtg42/ora-ora-ora/internal/listing"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+)
+
+// paletteItem 是命令面板中的候選項目：動作或筆記。
+type paletteItem struct {
+	command *command   // 候選的動作；為 nil 時是筆記。
+	note    *note.Note // 候選的筆記。
+	matches []int      // 標題中符合搜尋文字的位元組位置。
+}
+
+// title 返回候選項目顯示的標題。
+func (p paletteItem) title() string {
+	if p.command != nil {
+		return p.command.title
+	}
+	return p.note.Title
+}
+
+// newPaletteInput 建立命令面板的搜尋輸入框。
+func newPaletteInput() textinput.Model {
+	ti := textinput.New()
+	ti.Prompt = "> "
+	ti.Placeholder = i18n.T("palette.placeholder")
+	return ti
+}
+
+// openPalette 從列表視圖開啟命令面板，列出目前可執行的動作與所有筆記。
+func (m model) openPalette() (tea.Model, tea.Cmd) {
+	m.currentView = paletteView
+	m.paletteInput.SetValue("")
+	m.refreshPalette()
+	return m, m.paletteInput.Focus()
+}
+
+// closePalette 關閉命令面板並回到列表視圖。
+func (m model) closePalette() model {
+	m.currentView = listView
+	m.paletteInput.Blur()
+	return m
+}
+
+// refreshPalette 依搜尋文字重新計算候選項目並將游標移回第一項。
+// 沒有搜尋文字時動作依登錄順序排在前面，筆記依列表的排序排在後面；有搜尋文字時依模糊比對的分數排列。
+func (m *model) refreshPalette() {
+	var candidates []paletteItem
+	for _, c := range append(commands(), m.vaultCommands()...) {
+		if c.available(*m) {
+			candidates = append(candidates, paletteItem{command: &c})
+		}
+	}
+	for _, n := range listing.Sort(m.notes, m.order, m.views) {
+		candidates = append(candidates, paletteItem{note: n})
+	}
+	m.paletteCursor = 0
+	query := m.paletteInput.Value()
+	if query == "" {
+		m.paletteItems = candidates
+		return
+	}
+	// AI 心智註解: 動作也比對英文的動作名稱，中文介面下輸入 sort、export 等仍找得到；超出標題的比對位置不會被標示。
+	sources := make([]string, len(candidates))
+	for i, p := range candidates {
+		sources[i] = p.title()
+		if p.command != nil {
+			sources[i] += " " + p.command.id
+		}
+	}
+	m.paletteItems = nil
+	for _, match := range fuzzy.Find(query, sources) {
+		p := candidates[match.Index]
+		p.matches = match.MatchedIndexes
+		m.paletteItems = append(m.paletteItems, p)
+	}
+}
+
+// updatePalette 處理命令面板的按鍵：Enter 執行選中的動作或開啟筆記，Esc 關閉，上下鍵移動游標，其餘按鍵交給搜尋輸入框。
+func (m model) updatePalette(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Back):
+		return m.closePalette(), nil
+	case msg.Type == tea.KeyUp:
+		if m.paletteCursor > 0 {
+			m.paletteCursor--
+		}
+		return m, nil
+	case msg.Type == tea.KeyDown:
+		if m.paletteCursor < len(m.paletteItems)-1 {
+			m.paletteCursor++
+		}
+		return m, nil
+	case msg.Type == tea.KeyEnter:
+		if len(m.paletteItems) == 0 {
+			return m, nil
+		}
+		p := m.paletteItems[m.paletteCursor]
+		m = m.closePalette()
+		if p.command != nil {
+			return p.command.run(m)
+		}
+		m.selectPath(p.note.Path)
+		return m, m.openNote(p.note.Path)
+	}
+	var cmd tea.Cmd
+	m.paletteInput, cmd = m.paletteInput.Update(msg)
+	m.refreshPalette()
+	return m, cmd
+}
+
+// paletteRow 渲染命令面板中的一個候選項目：動作附上快捷鍵，筆記附上標記。
+func (m model) paletteRow(i int) string {
+	p := m.paletteItems[i]
+	cursor := " "
+	if i == m.paletteCursor {
+		cursor = m.styles.cursor.Render(">")
+	}
+	hint := i18n.T("palette.note")
+	if p.command != nil {
+		hint = ""
+		if p.command.binding != nil {
+			if b := p.command.binding(m.keys); b.Enabled() {
+				hint = b.Help().Key
+			}
+		}
+	}
+	row := cursor + " " + highlightMatches(p.title(), p.matches, m.styles.match)
+	if hint != "" {
+		row += "  " + m.styles.muted.Render(hint)
+	}
+	return row
+}
+
+// paletteViewString 渲染命令面板；已知終端高度時只顯示游標附近放得下的候選項目。
+func (m model) paletteViewString() string {
+	var b strings.Builder
+	b.WriteString(m.styles.header.Render(i18n.T("palette.header")) + "\n")
+	b.WriteString(m.paletteInput.View() + "\n")
+	if selected := m.selectedNote(); selected != nil {
+		b.WriteString(m.styles.muted.Render(i18n.T("palette.selected", selected.Title)) + "\n")
+	}
+	b.WriteString("\n")
+	help := m.footerView()
+
+	if len(m.paletteItems) == 0 {
+		b.WriteString(i18n.T("palette.no_match") + "\n")
+	}
+	first, last := 0, len(m.paletteItems)
+	if m.height > 0 {
+		height := max(m.height-strings.Count(b.String(), "\n")-lipgloss.Height(help)-2, 1)
+		first = max(m.paletteCursor-height+1, 0)
+		last = min(first+height, last)
+	}
+	for i := first; i < last; i++ {
+		b.WriteString(m.paletteRow(i) + "\n")
+	}
+	b.WriteString("\n" + help + "\n")
+	return b.String()
+}
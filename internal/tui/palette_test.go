@@ -0,0 +1,117 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/cursor"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// openTestPalette 按下 ctrl+p 開啟命令面板並輸入搜尋文字。
+func openTestPalette(t *testing.T, m model, query string) model {
+	updatedModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+	m = updatedModel.(model)
+	require.Equal(t, paletteView, m.currentView)
+	// AI 心智註解: 游標閃爍的指令會真的等待，固定游標讓 update 不必等待。
+	m.paletteInput.Cursor.SetMode(cursor.CursorStatic)
+	for _, r := range query {
+		m = update(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	return m
+}
+
+// paletteTitles 返回命令面板候選項目的標題。
+func paletteTitles(items []paletteItem) []string {
+	titles := make([]string, len(items))
+	for i, p := range items {
+		titles[i] = p.title()
+	}
+	return titles
+}
+
+// TestPalette_ListsCommandsThenNotes 測試未輸入搜尋文字時先列出動作，再依列表排序列出筆記。
+func TestPalette_ListsCommandsThenNotes(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	seedOrderNotes(t)
+
+	m := openTestPalette(t, loadedModel(), "")
+	titles := paletteTitles(m.paletteItems)
+	require.Len(t, titles, len(commands())+3)
+	assert.Equal(t, "查看選中的筆記", titles[0])
+	assert.Equal(t, []string{"beta", "Alpha", "gamma"}, titles[len(titles)-3:])
+	view := m.View()
+	assert.Contains(t, view, "命令面板")
+	assert.Contains(t, view, "選中的筆記：beta")
+	assert.Contains(t, view, "建立新筆記  n")
+}
+
+// TestPalette_RunsCommand 測試以模糊搜尋選擇動作後按 Enter 執行，並回到列表視圖。
+func TestPalette_RunsCommand(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	seedOrderNotes(t)
+
+	m := openTestPalette(t, loadedModel(), "反轉")
+	require.NotEmpty(t, m.paletteItems)
+	require.NotNil(t, m.paletteItems[0].command)
+	assert.Equal(t, "reverse", m.paletteItems[0].command.id)
+
+	m = update(m, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, listView, m.currentView)
+	assert.True(t, m.order.Desc)
+	assert.Equal(t, []string{"gamma", "Alpha", "beta"}, itemTitles(m.items))
+}
+
+// TestPalette_MatchesCommandID 測試中文介面下也能以英文的動作名稱搜尋動作。
+func TestPalette_MatchesCommandID(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	m := openTestPalette(t, loadedModel(), "export")
+	require.NotEmpty(t, m.paletteItems)
+	assert.Equal(t, "export", m.paletteItems[0].command.id)
+}
+
+// TestPalette_OpensNote 測試選擇筆記後開啟詳細視圖，列表游標移到該筆記。
+func TestPalette_OpensNote(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	seedOrderNotes(t)
+
+	m := openTestPalette(t, loadedModel(), "gamma")
+	require.Equal(t, []string{"gamma"}, paletteTitles(m.paletteItems))
+	assert.Contains(t, m.View(), "筆記")
+
+	m = update(m, tea.KeyMsg{Type: tea.KeyEnter})
+	require.Equal(t, detailView, m.currentView)
+	assert.Equal(t, "gamma", m.detailNote.Title)
+	assert.Equal(t, "gamma", m.selectedNote().Title)
+}
+
+// TestPalette_CursorAndEsc 測試上下鍵移動游標、沒有符合項目時顯示提示，Esc 關閉面板而不執行動作。
+func TestPalette_CursorAndEsc(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	m := openTestPalette(t, loadedModel(), "")
+	for _, p := range m.paletteItems {
+		assert.False(t, p.command.needsNote, "列表為空時不列出需要筆記的動作: %s", p.command.id)
+	}
+	m = update(m, tea.KeyMsg{Type: tea.KeyDown})
+	assert.Equal(t, 1, m.paletteCursor)
+	m = update(m, tea.KeyMsg{Type: tea.KeyUp})
+	m = update(m, tea.KeyMsg{Type: tea.KeyUp})
+	assert.Equal(t, 0, m.paletteCursor)
+
+	m = openTestPalette(t, update(m, tea.KeyMsg{Type: tea.KeyEsc}), "zzzz")
+	assert.Empty(t, m.paletteItems)
+	assert.Contains(t, m.View(), "沒有符合的動作或筆記")
+	m = update(m, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, paletteView, m.currentView)
+
+	m = update(m, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, listView, m.currentView)
+}
@@ -0,0 +1,85 @@
+// Package tui 提供了終端使用者介面 (TUI) 的實現。
+package tui
+
+import (
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+)
+
+// 超過任一門檻的貼上內容視為大量貼上，先詢問使用者是否直接存成筆記。
+const (
+	largePasteRunes = 4000
+	largePasteLines = 100
+)
+
+// largePasteMsg 訊息表示輸入區域收到大量貼上內容，尚未插入。
+type largePasteMsg struct {
+	text string
+}
+
+// normalizePaste 將終端貼上時送出的 CRLF 與 CR 統一為換行字元。
+// AI 心智註解: 多數終端在括號貼上模式中以 \r 表示換行，若不轉換會被當成單行文字。
+func normalizePaste(runes []rune) []rune {
+	text := strings.ReplaceAll(string(runes), "\r\n", "\n")
+	return []rune(strings.ReplaceAll(text, "\r", "\n"))
+}
+
+// isLargePaste 判斷貼上內容是否超過大量貼上的門檻。
+func isLargePaste(runes []rune) bool {
+	if len(runes) > largePasteRunes {
+		return true
+	}
+	lines := 1
+	for _, r := range runes {
+		if r == '\n' {
+			lines++
+		}
+	}
+	return lines > largePasteLines
+}
+
+// pastePrompt 返回大量貼上時顯示在底部說明列的提示。
+func (m model) pastePrompt() string {
+	runes := []rune(m.pendingPaste)
+	lines := strings.Count(m.pendingPaste, "\n") + 1
+	return i18n.T("paste.prompt", lines, len(runes))
+}
+
+// handlePastePrompt 處理大量貼上提示中的按鍵。
+func (m model) handlePastePrompt(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "s":
+		var cmd tea.Cmd
+		m, cmd = m.savePasteAsNote()
+		m.layout()
+		return m, cmd
+	case "i":
+		text := m.pendingPaste
+		m.pendingPaste = ""
+		m.inputArea.insertPaste([]rune(text))
+	case "esc", "ctrl+c":
+		m.pendingPaste = ""
+	default:
+		return m, nil
+	}
+	m.layout()
+	return m, nil
+}
+
+// savePasteAsNote 在背景將大量貼上內容直接存成筆記內容，並套用表單中的標籤。
+// 表單已填寫標題時使用該標題並在儲存後清空表單，否則以貼上時間命名。
+func (m model) savePasteAsNote() (model, tea.Cmd) {
+	text := m.pendingPaste
+	m.pendingPaste = ""
+	title := strings.TrimSpace(m.titleInput.Value())
+	fromInput := title != ""
+	if !fromInput {
+		title = i18n.T("paste.title", time.Now().Format("2006-01-02 150405"))
+	}
+	n := note.NewNote(title, text, parseTags(m.tagsInput.Value()))
+	return m, m.saveNote(n, i18n.T("paste.saved", n.Title, n.ID()), fromInput)
+}
@@ -0,0 +1,96 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+)
+
+// pasteKey 模擬終端括號貼上模式送出的按鍵訊息。
+func pasteKey(text string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(text), Paste: true}
+}
+
+func TestInputAreaPasteInsertsVerbatim(t *testing.T) {
+	// AI 心智註解: 貼上內容中的 CR 換行與 Tab 原樣保留，且不會觸發送出。
+	ia := pressKeys(NewInputArea(), typeText("前言"))
+	model, cmd := ia.Update(pasteKey("第一段\r\n\tq 縮排\r第二段"))
+	ia = model.(InputArea)
+
+	assert.Nil(t, cmd)
+	assert.Equal(t, "前言第一段\n\tq 縮排\n第二段", ia.Text())
+	assert.Contains(t, ia.View(), "    q 縮排")
+
+	// AI 心智註解: 一次貼上為獨立的復原步驟，不與前面的輸入合併。
+	ia = pressKeys(ia, tea.KeyMsg{Type: tea.KeyCtrlZ})
+	assert.Equal(t, "前言", ia.Text())
+}
+
+func TestInputAreaLargePasteIsDeferred(t *testing.T) {
+	ia := NewInputArea()
+	large := strings.Repeat("行\n", largePasteLines)
+	model, cmd := ia.Update(pasteKey(large))
+	ia = model.(InputArea)
+
+	require.NotNil(t, cmd)
+	assert.Equal(t, largePasteMsg{text: large}, cmd())
+	assert.Empty(t, ia.Text(), "大量貼上在使用者確認前不應插入")
+}
+
+func TestLargePaste_SaveAsNote(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	m := loadedModel()
+	updatedModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	m = updatedModel.(model)
+	updatedModel, _ = m.Update(typeText("會議紀錄"))
+	m = updatedModel.(model)
+
+	large := strings.Repeat("逐字稿\n", largePasteLines)
+	updatedModel, _ = m.Update(largePasteMsg{text: large})
+	m = updatedModel.(model)
+	assert.Contains(t, m.View(), "直接存成筆記")
+
+	// AI 心智註解: 詢問期間的 'q' 不應退出程式，也不應落入輸入區。
+	updatedModel, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+	m = updatedModel.(model)
+	assert.Nil(t, cmd)
+	assert.Equal(t, "會議紀錄", m.titleInput.Value())
+
+	m = update(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+
+	assert.Empty(t, m.pendingPaste)
+	assert.Empty(t, m.titleInput.Value())
+	assert.Equal(t, []string{"會議紀錄"}, noteTitles(m.notes))
+	content, err := storage.ReadNote("會議紀錄")
+	require.NoError(t, err)
+	assert.Equal(t, large, content)
+	assert.Equal(t, roleSystem, m.history[len(m.history)-1].role)
+}
+
+func TestLargePaste_InsertOrCancel(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	m := loadedModel()
+	m.currentView = createView
+
+	updatedModel, _ := m.Update(largePasteMsg{text: "大量\n內容"})
+	m = updatedModel.(model)
+	updatedModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'i'}})
+	m = updatedModel.(model)
+	assert.Equal(t, "大量\n內容", m.inputArea.Text())
+
+	updatedModel, _ = m.Update(largePasteMsg{text: "其他"})
+	m = updatedModel.(model)
+	updatedModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updatedModel.(model)
+	assert.Empty(t, m.pendingPaste)
+	assert.Equal(t, createView, m.currentView)
+	assert.Equal(t, "大量\n內容", m.inputArea.Text())
+}
@@ -0,0 +1,66 @@
+// Package tui 提供了終端使用者介面 (TUI) 的實現。
+package tui
+
+import (
+	"slices"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+	"github.com/wtg42/ora-ora-ora/internal/listing"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+)
+
+// pinMarker 是列表中釘選筆記標題前的標記。
+const pinMarker = "★"
+
+// notePinnedMsg 訊息表示筆記的釘選狀態已在背景寫回檔案。
+type notePinnedMsg struct {
+	note *note.Note
+	err  error
+}
+
+// togglePin 返回在背景切換筆記釘選狀態的指令。
+func (m *model) togglePin(n *note.Note) tea.Cmd {
+	m.saving++
+	path, pinned := n.Path, !n.Pinned
+	return tea.Batch(func() tea.Msg {
+		n, err := storage.SetPinned(path, pinned)
+		return notePinnedMsg{note: n, err: err}
+	}, m.spinner.Tick)
+}
+
+// pinnedNotes 返回依目前排序排列的釘選筆記，順序即快速開啟的編號，不受篩選影響。
+func (m model) pinnedNotes() []*note.Note {
+	var pinned []*note.Note
+	for _, n := range listing.Sort(m.notes, m.order, m.views) {
+		if n.Pinned {
+			pinned = append(pinned, n)
+		}
+	}
+	return pinned
+}
+
+// pinLabels 返回釘選筆記在列表中的標記：可快速開啟的筆記附上按鍵，其餘只有 pinMarker。
+func (m model) pinLabels() map[*note.Note]string {
+	keys := m.keys.Jump.Keys()
+	labels := map[*note.Note]string{}
+	for i, n := range m.pinnedNotes() {
+		labels[n] = pinMarker
+		if m.keys.Jump.Enabled() && i < len(keys) {
+			labels[n] += keys[i]
+		}
+	}
+	return labels
+}
+
+// openPinned 開啟依 Jump 按鍵順序對應的釘選筆記，例如預設的 '1' 開啟第一則。
+func (m model) openPinned(keyName string) (tea.Model, tea.Cmd) {
+	i := slices.Index(m.keys.Jump.Keys(), keyName)
+	pinned := m.pinnedNotes()
+	if i < 0 || i >= len(pinned) {
+		return m, m.setStatus(statusInfo, i18n.T("load.no_pinned"), keyName)
+	}
+	m.selectPath(pinned[i].Path)
+	return m, m.openNote(pinned[i].Path)
+}
@@ -0,0 +1,79 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtg42/ora-ora-ora/internal/listing"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+)
+
+// TestPin_ToggleMovesToTop 測試 '*' 釘選筆記後固定在列表最上方並顯示標記，再按一次取消釘選。
+func TestPin_ToggleMovesToTop(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	seedOrderNotes(t)
+
+	m := pressRune(loadedModel(), 'j')
+	m = pressRune(pressRune(m, 'j'), '*')
+	assert.Equal(t, []string{"gamma", "beta", "Alpha"}, itemTitles(m.items))
+	assert.Equal(t, "gamma", m.selectedNote().Title, "游標跟著釘選的筆記移動")
+	assert.Contains(t, m.View(), pinMarker+"1 gamma")
+	assert.Contains(t, m.View(), "已釘選「gamma」")
+
+	path, err := storage.FindNotePath("gamma")
+	require.NoError(t, err)
+	n, err := storage.LoadNote(path)
+	require.NoError(t, err)
+	assert.True(t, n.Pinned)
+
+	m = pressRune(m, '*')
+	assert.Equal(t, []string{"beta", "Alpha", "gamma"}, itemTitles(m.items))
+	assert.NotContains(t, m.View(), pinMarker)
+}
+
+// TestPin_JumpOpensPinnedNote 測試數字鍵依釘選順序開啟筆記，不受篩選影響。
+func TestPin_JumpOpensPinnedNote(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	seedOrderNotes(t)
+	for _, ref := range []string{"beta", "gamma"} {
+		path, err := storage.FindNotePath(ref)
+		require.NoError(t, err)
+		_, err = storage.SetPinned(path, true)
+		require.NoError(t, err)
+	}
+
+	m := typeFilter(t, loadedModel(), "Alpha")
+	m = update(m, tea.KeyMsg{Type: tea.KeyEnter})
+	m = pressRune(m, '2')
+	require.Equal(t, detailView, m.currentView)
+	assert.Equal(t, "gamma", m.detailNote.Title)
+
+	m = update(m, tea.KeyMsg{Type: tea.KeyEsc})
+	m = pressRune(m, '3')
+	assert.Equal(t, listView, m.currentView)
+	assert.Contains(t, m.View(), "按鍵 3 沒有對應的釘選筆記")
+}
+
+// TestPin_GroupedAsOwnGroup 測試分組時釘選筆記自成一組並排在最前，不重複出現在其他組。
+func TestPin_GroupedAsOwnGroup(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	seedOrderNotes(t)
+	path, err := storage.FindNotePath("gamma")
+	require.NoError(t, err)
+	_, err = storage.SetPinned(path, true)
+	require.NoError(t, err)
+
+	m := loadedModel()
+	m.order.Group = listing.GroupTag
+	m.applyFilter()
+	assert.Equal(t, []string{"gamma", "beta", "Alpha"}, itemTitles(m.items))
+	view := m.View()
+	assert.Less(t, strings.Index(view, "釘選"), strings.Index(view, "#work"))
+	assert.NotContains(t, view, "#home", "釘選筆記的標籤不另外成組")
+}
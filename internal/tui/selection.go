@@ -0,0 +1,291 @@
+// Package tui 提供了終端使用者介面 (TUI) 的實現。
+package tui
+
+import (
+	"bytes"
+	"maps"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wtg42/ora-ora-ora/internal/export"
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+	"github.com/wtg42/ora-ora-ora/internal/listing"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+)
+
+// selectMarker 是列表中已選取筆記標題前的標記。
+const selectMarker = "●"
+
+// bulkAction 是對選取的筆記執行的批次動作。
+type bulkAction int
+
+const (
+	bulkNone       bulkAction = iota // 沒有進行中的批次動作。
+	bulkAddTags                      // 加上標籤。
+	bulkRemoveTags                   // 移除標籤。
+	bulkMove                         // 移到其他資料夾。
+	bulkTrash                        // 移到垃圾桶。
+)
+
+// bulkDoneMsg 訊息表示批次動作已在背景完成；部分筆記失敗時 errs 記錄各筆記的錯誤。
+type bulkDoneMsg struct {
+	action bulkAction
+	done   int
+	errs   []error
+}
+
+// newBulkInput 建立批次動作輸入標籤或資料夾的輸入框。
+func newBulkInput() textinput.Model {
+	return textinput.New()
+}
+
+// selecting 判斷列表是否有選取的筆記或正在以 V 選取範圍。
+func (m model) selecting() bool {
+	return m.visual || len(m.selected) > 0
+}
+
+// visualRange 返回範圍選取中起點與游標之間的列表項目索引（含兩端）；起點已不在列表中時只包含游標。
+func (m model) visualRange() (int, int) {
+	anchor := m.cursor
+	for i, item := range m.items {
+		if item.note.Path == m.visualAnchor {
+			anchor = i
+			break
+		}
+	}
+	return min(anchor, m.cursor), max(anchor, m.cursor)
+}
+
+// selectionMarks 返回列表中應標示為已選取的筆記，包含範圍選取中尚未確定的項目。
+func (m model) selectionMarks() map[*note.Note]bool {
+	marks := map[*note.Note]bool{}
+	for _, item := range m.items {
+		if m.selected[item.note.Path] {
+			marks[item.note] = true
+		}
+	}
+	if m.visual && len(m.items) > 0 {
+		first, last := m.visualRange()
+		for i := first; i <= last; i++ {
+			marks[m.items[i].note] = true
+		}
+	}
+	return marks
+}
+
+// targets 返回批次動作的對象：已選取的筆記（含篩選後未顯示的）依目前排序排列；沒有選取時為游標所在的筆記。
+func (m model) targets() []*note.Note {
+	if !m.selecting() {
+		if n := m.selectedNote(); n != nil {
+			return []*note.Note{n}
+		}
+		return nil
+	}
+	marks := m.selectionMarks()
+	var notes []*note.Note
+	for _, n := range listing.Sort(m.notes, m.order, m.views) {
+		if marks[n] || m.selected[n.Path] {
+			notes = append(notes, n)
+		}
+	}
+	return notes
+}
+
+// toggleSelect 切換游標所在筆記的選取狀態並將游標移到下一則。
+func (m model) toggleSelect() (tea.Model, tea.Cmd) {
+	// AI 心智註解: model 以值傳遞，複製後再修改，避免舊的 model 共用同一個 map。
+	path := m.selectedNote().Path
+	m.selected = maps.Clone(m.selected)
+	if m.selected == nil {
+		m.selected = map[string]bool{}
+	}
+	if m.selected[path] {
+		delete(m.selected, path)
+	} else {
+		m.selected[path] = true
+	}
+	m.cursor = min(m.cursor+1, len(m.items)-1)
+	return m, nil
+}
+
+// toggleVisual 開始範圍選取，再按一次時將起點到游標之間的筆記加入選取。
+func (m model) toggleVisual() (tea.Model, tea.Cmd) {
+	if !m.visual {
+		m.visual = true
+		m.visualAnchor = m.selectedNote().Path
+		return m, nil
+	}
+	m.selected = m.commitVisual()
+	m.visual = false
+	return m, nil
+}
+
+// commitVisual 返回加入範圍選取後的選取集合。
+func (m model) commitVisual() map[string]bool {
+	selected := maps.Clone(m.selected)
+	if selected == nil {
+		selected = map[string]bool{}
+	}
+	if m.visual && len(m.items) > 0 {
+		first, last := m.visualRange()
+		for i := first; i <= last; i++ {
+			selected[m.items[i].note.Path] = true
+		}
+	}
+	return selected
+}
+
+// clearSelection 清除選取並結束範圍選取。
+func (m model) clearSelection() model {
+	m.selected = nil
+	m.visual = false
+	m.visualAnchor = ""
+	return m
+}
+
+// startBulk 開始需要輸入或確認的批次動作：標籤與資料夾由輸入框輸入，移到垃圾桶需按 y 確認。
+func (m model) startBulk(action bulkAction) (tea.Model, tea.Cmd) {
+	m.selected = m.commitVisual()
+	m.visual = false
+	m.bulk = action
+	if action == bulkTrash {
+		return m, nil
+	}
+	prompts := map[bulkAction]string{
+		bulkAddTags:    "bulk.add_tags_prompt",
+		bulkRemoveTags: "bulk.remove_tags_prompt",
+		bulkMove:       "bulk.move_prompt",
+	}
+	m.bulkInput.Prompt = i18n.T(prompts[action], len(m.targets())) + " "
+	m.bulkInput.SetValue("")
+	return m, m.bulkInput.Focus()
+}
+
+// cancelBulk 取消進行中的批次動作，保留選取。
+func (m model) cancelBulk() model {
+	m.bulk = bulkNone
+	m.bulkInput.Blur()
+	return m
+}
+
+// bulkPrompt 返回批次動作顯示在列表上方的輸入框或確認提示，沒有進行中的批次動作時返回空字串。
+func (m model) bulkPrompt() string {
+	switch m.bulk {
+	case bulkNone:
+		return ""
+	case bulkTrash:
+		return m.styles.history[roleError].Render(i18n.T("bulk.trash_prompt", len(m.targets())))
+	}
+	return m.bulkInput.View()
+}
+
+// updateBulk 處理批次動作的按鍵：移到垃圾桶只在按 y 時執行，其餘動作以 Enter 送出輸入、Esc 取消。
+func (m model) updateBulk(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.bulk == bulkTrash {
+		if msg.String() != "y" {
+			return m.cancelBulk(), nil
+		}
+		return m.runBulk(bulkTrash, "")
+	}
+	switch {
+	case key.Matches(msg, m.keys.Back):
+		return m.cancelBulk(), nil
+	case msg.Type == tea.KeyEnter:
+		return m.runBulk(m.bulk, strings.TrimSpace(m.bulkInput.Value()))
+	}
+	var cmd tea.Cmd
+	m.bulkInput, cmd = m.bulkInput.Update(msg)
+	return m, cmd
+}
+
+// runBulk 在背景對批次動作的對象逐一執行動作；value 是輸入的標籤（以逗號分隔）或資料夾。
+func (m model) runBulk(action bulkAction, value string) (tea.Model, tea.Cmd) {
+	notes := m.targets()
+	m = m.cancelBulk()
+	tags := parseTags(value)
+	switch {
+	case len(notes) == 0:
+		return m, nil
+	case (action == bulkAddTags || action == bulkRemoveTags) && len(tags) == 0:
+		return m, nil
+	case action == bulkAddTags:
+		if err := validateTags(value); err != nil {
+			return m, m.setStatus(statusWarning, "%v", err)
+		}
+	}
+	folder := strings.Trim(value, "/")
+	paths := make([]string, len(notes))
+	for i, n := range notes {
+		paths[i] = n.Path
+	}
+	m.saving++
+	return m, tea.Batch(func() tea.Msg {
+		msg := bulkDoneMsg{action: action}
+		for _, path := range paths {
+			var err error
+			switch action {
+			case bulkAddTags:
+				_, err = storage.SetTags(path, tags, nil)
+			case bulkRemoveTags:
+				_, err = storage.SetTags(path, nil, tags)
+			case bulkMove:
+				_, err = storage.MoveNote(path, folder)
+			case bulkTrash:
+				_, err = storage.TrashNote(path)
+			}
+			if err != nil {
+				msg.errs = append(msg.errs, err)
+				continue
+			}
+			msg.done++
+		}
+		return msg
+	}, m.spinner.Tick)
+}
+
+// finishBulk 處理批次動作的結果：清除選取、重新載入筆記並在狀態列顯示成功數量與第一個錯誤。
+func (m model) finishBulk(msg bulkDoneMsg) (model, tea.Cmd) {
+	m.saving--
+	m = m.clearSelection()
+	statuses := map[bulkAction]string{
+		bulkAddTags:    "bulk.tagged",
+		bulkRemoveTags: "bulk.untagged",
+		bulkMove:       "bulk.moved",
+		bulkTrash:      "bulk.trashed",
+	}
+	status := m.setStatus(statusInfo, i18n.T(statuses[msg.action]), msg.done)
+	if len(msg.errs) > 0 {
+		// AI 心智註解: 狀態列只顯示第一個錯誤，其餘錯誤先各自記入錯誤紀錄，方便逐一查看失敗的筆記。
+		for _, err := range msg.errs[1:] {
+			m.setStatus(statusError, "%s", i18n.Error(err))
+		}
+		status = m.setStatus(statusError, i18n.T("bulk.failed"), msg.done, len(msg.errs), i18n.Error(msg.errs[0]))
+	}
+	return m, tea.Batch(m.loadNotes(), status)
+}
+
+// copySelection 將批次動作的對象依目前的順序組成單一 Markdown 內容並複製到剪貼簿。
+func (m model) copySelection() (tea.Model, tea.Cmd) {
+	notes := m.targets()
+	m.selected = m.commitVisual()
+	m.visual = false
+	var buf bytes.Buffer
+	if err := export.WriteBundle(&buf, notes); err != nil {
+		return m, m.setStatus(statusError, i18n.T("error.copy"), err)
+	}
+	return m, m.copyText(buf.String(), i18n.T("bulk.copied", len(notes)))
+}
+
+// selectionLabel 返回列表標題列中顯示的選取狀態，沒有選取時返回空字串。
+func (m model) selectionLabel() string {
+	switch {
+	case m.visual:
+		return i18n.T("bulk.visual", len(m.targets()))
+	case len(m.selected) > 0:
+		return i18n.T("bulk.selected", len(m.targets()))
+	}
+	return ""
+}
@@ -0,0 +1,226 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/cursor"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtg42/ora-ora-ora/internal/clipboard"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+)
+
+// startTestBulk 在列表中按下批次動作的按鍵並輸入文字，返回等待送出的 model。
+func startTestBulk(t *testing.T, m model, r rune, value string) model {
+	t.Helper()
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	require.NotEqual(t, bulkNone, m.bulk)
+	// AI 心智註解: 游標閃爍的指令會真的等待，固定游標讓 update 只執行送出後的背景指令。
+	m.bulkInput.Cursor.SetMode(cursor.CursorStatic)
+	for _, r := range value {
+		m = pressRune(m, r)
+	}
+	return m
+}
+
+// noteByTitle 返回已載入筆記中指定標題的筆記。
+func noteByTitle(t *testing.T, m model, title string) *note.Note {
+	t.Helper()
+	for _, n := range m.notes {
+		if n.Title == title {
+			return n
+		}
+	}
+	require.Failf(t, "找不到筆記", "%s", title)
+	return nil
+}
+
+// TestSelection_ToggleAndRange 測試空白鍵切換選取並下移游標，V 以範圍加入選取，Esc 依序取消範圍與清除選取。
+func TestSelection_ToggleAndRange(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	seedOrderNotes(t)
+
+	m := loadedModel()
+	m = pressRune(m, ' ')
+	assert.Equal(t, 1, m.cursor)
+	assert.Equal(t, []string{"beta"}, noteTitles(m.targets()))
+	assert.Contains(t, m.listViewString(), "已選取 1 則")
+	assert.Contains(t, m.listViewString(), selectMarker+" beta")
+
+	m = pressRune(m, 'V')
+	m = pressRune(m, 'j')
+	assert.Contains(t, m.listViewString(), "範圍選取 3 則")
+	assert.Equal(t, []string{"beta", "Alpha", "gamma"}, noteTitles(m.targets()))
+
+	// Esc 先取消範圍選取，保留已確定的選取。
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.False(t, m.visual)
+	assert.Equal(t, []string{"beta"}, noteTitles(m.targets()))
+
+	m = pressRune(m, 'V')
+	m = pressRune(m, 'k')
+	m = pressRune(m, 'V')
+	assert.False(t, m.visual)
+	assert.Equal(t, []string{"beta", "Alpha", "gamma"}, noteTitles(m.targets()))
+
+	// 再按空白鍵取消選取游標所在的筆記。
+	m.cursor = 0
+	m = pressRune(m, ' ')
+	assert.Equal(t, []string{"Alpha", "gamma"}, noteTitles(m.targets()))
+
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.False(t, m.selecting())
+	assert.NotContains(t, m.listViewString(), selectMarker)
+	assert.Equal(t, []string{"Alpha"}, noteTitles(m.targets()))
+}
+
+// TestSelection_KeptAcrossFilter 測試篩選後未顯示的選取筆記仍是批次動作的對象。
+func TestSelection_KeptAcrossFilter(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	seedOrderNotes(t)
+
+	m := pressRune(loadedModel(), ' ')
+	m = typeFilter(t, m, "gam")
+	m = update(m, tea.KeyMsg{Type: tea.KeyEnter})
+	m = pressRune(m, ' ')
+	assert.Equal(t, []string{"beta", "gamma"}, noteTitles(m.targets()))
+
+	// Esc 先清除選取，再按一次才清除篩選。
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.False(t, m.selecting())
+	assert.Equal(t, "gam", m.filterInput.Value())
+}
+
+// TestBulk_Tags 測試為選取的筆記加上與移除標籤，完成後清除選取並重新載入。
+func TestBulk_Tags(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	seedOrderNotes(t)
+
+	m := pressRune(loadedModel(), ' ')
+	m = pressRune(m, ' ')
+	m = startTestBulk(t, m, '+', "review, #Work")
+	assert.Contains(t, m.listViewString(), "為 2 則筆記加上標籤")
+	m = update(m, tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, m.status)
+	assert.Equal(t, "已為 2 則筆記加上標籤", m.status.text)
+	assert.False(t, m.selecting())
+	assert.Equal(t, 0, m.saving)
+	assert.Equal(t, []string{"work", "review"}, noteByTitle(t, m, "beta").Tags)
+	assert.Equal(t, []string{"review", "Work"}, noteByTitle(t, m, "Alpha").Tags)
+
+	// 沒有選取時對游標所在的筆記執行。
+	m.cursor = 0
+	m = startTestBulk(t, m, '-', "work")
+	m = update(m, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, []string{"review"}, noteByTitle(t, m, "beta").Tags)
+	assert.Equal(t, []string{"review", "Work"}, noteByTitle(t, m, "Alpha").Tags)
+
+	// Esc 取消輸入，不修改筆記。
+	m = startTestBulk(t, m, '+', "x")
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, bulkNone, m.bulk)
+	assert.Equal(t, []string{"review"}, noteByTitle(t, m, "beta").Tags)
+}
+
+// TestBulk_MoveAndTrash 測試將選取的筆記移到資料夾，以及確認後才移到垃圾桶。
+func TestBulk_MoveAndTrash(t *testing.T) {
+	dataDir, teardown := setupTestDataDir(t)
+	defer teardown()
+	seedOrderNotes(t)
+
+	m := pressRune(loadedModel(), ' ')
+	m = pressRune(m, ' ')
+	m = startTestBulk(t, m, 'm', "archive")
+	m = update(m, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, "已移動 2 則筆記", m.status.text)
+	assert.Equal(t, "archive", noteByTitle(t, m, "beta").Folder)
+	assert.Equal(t, "archive", noteByTitle(t, m, "Alpha").Folder)
+	assert.Equal(t, "", noteByTitle(t, m, "gamma").Folder)
+
+	// 除了 y 之外的按鍵都取消移到垃圾桶。
+	m = pressRune(m, 'd')
+	assert.Contains(t, m.listViewString(), "將 1 則筆記移到垃圾桶？")
+	m = pressRune(m, 'n')
+	assert.Equal(t, bulkNone, m.bulk)
+	assert.Len(t, m.notes, 3)
+
+	gamma := noteByTitle(t, m, "gamma")
+	m.selectPath(gamma.Path)
+	m = pressRune(m, 'd')
+	m = pressRune(m, 'y')
+	assert.Equal(t, "已將 1 則筆記移到垃圾桶", m.status.text)
+	assert.Len(t, m.notes, 2)
+	assert.NoFileExists(t, gamma.Path)
+	trashDir, err := storage.GetAppDataSubDir("trash")
+	require.NoError(t, err)
+	assert.Contains(t, trashDir, dataDir)
+	assert.FileExists(t, filepath.Join(trashDir, filepath.Base(gamma.Path)))
+}
+
+// TestBulk_PartialFailure 測試部分筆記失敗時在狀態列顯示完成與失敗的數量。
+func TestBulk_PartialFailure(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	seedOrderNotes(t)
+
+	m := pressRune(loadedModel(), ' ')
+	m = pressRune(m, ' ')
+	require.NoError(t, os.Remove(noteByTitle(t, m, "beta").Path))
+	m = startTestBulk(t, m, '+', "x")
+	m = update(m, tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, m.status)
+	assert.Equal(t, statusError, m.status.level)
+	assert.Contains(t, m.status.text, "1 則筆記完成，1 則失敗")
+}
+
+// TestCopySelection 測試將選取的筆記組成 Markdown 內容複製到剪貼簿。
+func TestCopySelection(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	seedOrderNotes(t)
+	var copied string
+	original := copyToClipboard
+	copyToClipboard = func(_ clipboard.Mode, text string) error {
+		copied = text
+		return nil
+	}
+	defer func() { copyToClipboard = original }()
+
+	m := pressRune(loadedModel(), ' ')
+	m.cursor = 2
+	m = pressRune(m, ' ')
+	m = pressRune(m, 'Y')
+	assert.Equal(t, "已將 2 則筆記複製到剪貼簿", m.status.text)
+	assert.Contains(t, copied, "## beta")
+	assert.Contains(t, copied, "## gamma")
+	assert.NotContains(t, copied, "## Alpha")
+	// 複製不修改筆記，保留選取。
+	assert.True(t, m.selecting())
+}
+
+// TestExportListed_Selection 測試有選取時只匯出選取的筆記。
+func TestExportListed_Selection(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	seedOrderNotes(t)
+
+	m := pressRune(loadedModel(), ' ')
+	next, cmd := m.exportListed()
+	m = runCmd(next.(model), cmd)
+	exportDir, err := storage.GetAppDataSubDir("exports")
+	require.NoError(t, err)
+	files, err := filepath.Glob(filepath.Join(exportDir, "ora-*.md"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	data, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "## beta")
+	assert.NotContains(t, string(data), "## gamma")
+}
@@ -0,0 +1,182 @@
+// Package tui 提供了終端使用者介面 (TUI) 的實現。
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+)
+
+// 版面配置模式，對應配置檔案中的 layout。
+const (
+	layoutSplit  = "split"  // 左側列表、右側預覽（預設）。
+	layoutSingle = "single" // 只顯示列表。
+)
+
+// 雙欄版面的尺寸設定。
+const (
+	splitMinWidth    = 80 // 終端寬度小於此值時收合為單欄。
+	listPaneMinWidth = 32 // 左側列表的最小寬度。
+	listPaneMaxWidth = 60 // 左側列表的最大寬度。
+	dateColumnWidth  = 10 // 日期欄寬度（YYYY-MM-DD）。
+	tagsColumnWidth  = 14 // 標籤欄寬度。
+)
+
+// previewCache 快取最近一次渲染的預覽，避免每次重繪都重新渲染 Markdown。
+// AI 心智註解: model 以值傳遞，快取以指標保存才能跨 Update 與 View 共用。
+type previewCache struct {
+	note     *note.Note
+	style    string
+	width    int
+	rendered string
+}
+
+// render 以 glamour 的標準樣式 style 返回筆記在指定寬度下的預覽內容，筆記、樣式或寬度改變時才重新渲染。
+// AI 心智註解: 樣式會因命令面板切換主題而改變，因此列入快取的判斷。
+func (c *previewCache) render(n *note.Note, style string, width int) string {
+	if c.note == n && c.style == style && c.width == width {
+		return c.rendered
+	}
+	rendered, err := renderMarkdown(n.Content, style, width)
+	if err != nil {
+		rendered = n.Content
+	}
+	c.note, c.style, c.width, c.rendered = n, style, width, strings.TrimRight(rendered, "\n")
+	return c.rendered
+}
+
+// splitActive 判斷列表視圖是否使用雙欄版面：需啟用雙欄模式且終端夠寬。
+func (m model) splitActive() bool {
+	return m.splitPane && m.width >= splitMinWidth
+}
+
+// listPaneWidth 返回雙欄版面中左側列表的寬度。
+func (m model) listPaneWidth() int {
+	return max(min(m.width*2/5, listPaneMaxWidth), listPaneMinWidth)
+}
+
+// visibleItems 返回高度 height 內應顯示的列表項目範圍，確保游標可見；height 為 0 表示不限制。
+func (m model) visibleItems(height int) (int, int) {
+	if height <= 0 || len(m.items) <= height {
+		return 0, len(m.items)
+	}
+	start := max(min(m.cursor-height/2, len(m.items)-height), 0)
+	return start, start + height
+}
+
+// fitWidth 將字串截斷或以空白補齊到指定的顯示寬度，保留其中的樣式。
+func fitWidth(s string, width int) string {
+	s = ansi.Truncate(s, width, "…")
+	return s + strings.Repeat(" ", max(width-ansi.StringWidth(s), 0))
+}
+
+// listRow 渲染列表中的一列；columns 為 true 時在標題後加上日期與標籤欄。
+// pins 為釘選筆記的標記，顯示在標題前；marks 為已選取的筆記，選取中（marks 不為 nil）時在游標後多一欄選取標記。
+func (m model) listRow(i int, width int, columns bool, pins map[*note.Note]string, marks map[*note.Note]bool) string {
+	item := m.items[i]
+	cursor := " "
+	if m.cursor == i {
+		cursor = m.styles.cursor.Render(">")
+	}
+	if marks != nil {
+		mark := " "
+		if marks[item.note] {
+			mark = m.styles.cursor.Render(selectMarker)
+		}
+		cursor += mark
+		width--
+	}
+	title := highlightMatches(item.note.Title, item.matches, m.styles.match)
+	if pin, ok := pins[item.note]; ok {
+		title = m.styles.match.Render(pin) + " " + title
+	}
+	if !columns {
+		return fmt.Sprintf("%s %s", cursor, title)
+	}
+	tags := make([]string, len(item.note.Tags))
+	for j, t := range item.note.Tags {
+		tags[j] = "#" + t
+	}
+	titleWidth := max(width-2-dateColumnWidth-tagsColumnWidth-2, 1)
+	return fmt.Sprintf("%s %s %s %s", cursor,
+		fitWidth(title, titleWidth),
+		m.styles.muted.Render(fitWidth(m.listDate(item.note), dateColumnWidth)),
+		m.styles.muted.Render(fitWidth(strings.Join(tags, " "), tagsColumnWidth)))
+}
+
+// listLine 是列表中顯示的一行：項目或組名。
+type listLine struct {
+	item  int    // 項目在 m.items 中的索引，組名的行為 -1。
+	group string // 組名的行顯示的組名。
+}
+
+// listLines 返回高度 height 內顯示的行；分組時在每組的第一個項目前加上組名。
+// 渲染與滑鼠點擊共用同一份行配置，點擊的行才能對應到畫面上的項目。
+func (m model) listLines(height int) []listLine {
+	start, end := m.visibleItems(height)
+	lines := make([]listLine, 0, end-start)
+	cursorLine := 0
+	for i := start; i < end; i++ {
+		if group := m.items[i].group; group != "" && (i == start || m.items[i-1].group != group) {
+			lines = append(lines, listLine{item: -1, group: group})
+		}
+		if i == m.cursor {
+			cursorLine = len(lines)
+		}
+		lines = append(lines, listLine{item: i})
+	}
+	// AI 心智註解: 組名佔用額外的行，超出高度時以游標所在的行為中心截掉多出的行。
+	if height > 0 && len(lines) > height {
+		first := max(min(cursorLine-height/2, len(lines)-height), 0)
+		lines = lines[first : first+height]
+	}
+	return lines
+}
+
+// listRows 渲染高度 height 內的列表列。
+func (m model) listRows(width, height int, columns bool) string {
+	lines := m.listLines(height)
+	rows := make([]string, len(lines))
+	pins := m.pinLabels()
+	var marks map[*note.Note]bool
+	if m.selecting() {
+		marks = m.selectionMarks()
+	}
+	for i, line := range lines {
+		if line.item >= 0 {
+			rows[i] = m.listRow(line.item, width, columns, pins, marks)
+			continue
+		}
+		group := line.group
+		if width > 0 {
+			group = ansi.Truncate(group, width, "…")
+		}
+		rows[i] = m.styles.muted.Render(group)
+	}
+	return strings.Join(rows, "\n")
+}
+
+// previewPane 渲染右側預覽窗格：標題與以 Markdown 渲染的內容，截斷到 height 行。
+func (m model) previewPane(width, height int) string {
+	contentWidth := max(width-m.styles.previewBorder.GetHorizontalFrameSize(), 1)
+	var lines []string
+	if n := m.selectedNote(); n != nil {
+		lines = append(lines, m.styles.header.Render(fitWidth(n.Title, contentWidth)))
+		lines = append(lines, strings.Split(m.preview.render(n, m.styles.markdown, contentWidth), "\n")...)
+	}
+	if len(lines) > height {
+		lines = lines[:height]
+	}
+	return m.styles.previewBorder.Height(height).Render(strings.Join(lines, "\n"))
+}
+
+// splitBody 渲染雙欄版面的主體：左側含日期與標籤欄的列表，右側即時預覽。
+func (m model) splitBody(height int) string {
+	listWidth := m.listPaneWidth()
+	left := lipgloss.NewStyle().Width(listWidth).Height(height).Render(m.listRows(listWidth, height, true))
+	right := m.previewPane(m.width-listWidth-1, height)
+	return lipgloss.JoinHorizontal(lipgloss.Top, left, " ", right)
+}
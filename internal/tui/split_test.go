@@ -0,0 +1,110 @@
+package tui
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+)
+
+// resize 送出終端尺寸變更並返回更新後的 model。
+func resize(m model, width, height int) model {
+	updatedModel, _ := m.Update(tea.WindowSizeMsg{Width: width, Height: height})
+	return updatedModel.(model)
+}
+
+// TestSplitView_ColumnsAndLivePreview 測試寬終端顯示日期與標籤欄，預覽隨游標更新。
+func TestSplitView_ColumnsAndLivePreview(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	seedFilterNotes(t)
+
+	m := resize(loadedModel(), 100, 20)
+	require.True(t, m.splitActive())
+	view := ansi.Strip(m.View())
+	assert.Contains(t, view, "2026-09-01")
+	assert.Contains(t, view, "#work")
+	assert.Contains(t, view, "進度")
+	assert.NotContains(t, view, "牛奶、雞蛋")
+	assert.Equal(t, 20, lipgloss.Height(m.View()))
+
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyDown})
+	view = ansi.Strip(m.View())
+	assert.Contains(t, view, "牛奶、雞蛋")
+	assert.Contains(t, view, "#home")
+}
+
+// TestSplitView_CollapsesOnNarrowTerminal 測試窄終端與按下預覽鍵時收合為單欄。
+func TestSplitView_CollapsesOnNarrowTerminal(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	seedFilterNotes(t)
+
+	m := resize(loadedModel(), splitMinWidth-1, 20)
+	assert.False(t, m.splitActive())
+	view := ansi.Strip(m.View())
+	assert.Contains(t, view, "> Weekly report")
+	assert.NotContains(t, view, "2026-09-01")
+	assert.NotContains(t, view, "進度")
+
+	m = resize(m, 100, 20)
+	assert.True(t, m.splitActive())
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'p'}})
+	assert.False(t, m.splitActive())
+	assert.NotContains(t, ansi.Strip(m.View()), "進度")
+}
+
+// TestSplitView_LayoutConfig 測試配置檔案的 layout 設定。
+func TestSplitView_LayoutConfig(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	setupTestConfig(t, `layout = "single"`)
+	m := resize(loadedModel(), 100, 20)
+	assert.Empty(t, m.errorLog)
+	assert.False(t, m.splitActive())
+
+	setupTestConfig(t, `layout = "grid"`)
+	m = InitialModel()
+	assert.Contains(t, m.statusView(), `"grid"`)
+	assert.True(t, m.splitPane)
+}
+
+// TestListView_ScrollsToCursor 測試列表超過終端高度時只顯示游標附近的項目。
+func TestListView_ScrollsToCursor(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	base := time.Date(2026, 9, 1, 9, 0, 0, 0, time.Local)
+	for i := range 30 {
+		n := &note.Note{Title: fmt.Sprintf("筆記 %02d", i), Content: "內容", CreatedAt: base.Add(time.Duration(i) * time.Minute)}
+		require.NoError(t, storage.SaveNote(n))
+	}
+
+	m := resize(loadedModel(), 60, 12)
+	for range 25 {
+		m = pressKey(m, tea.KeyMsg{Type: tea.KeyDown})
+	}
+	view := m.View()
+	assert.Contains(t, view, "> "+m.selectedNote().Title)
+	assert.NotContains(t, view, "筆記 00")
+	assert.Equal(t, 12, lipgloss.Height(view))
+}
+
+func TestPreviewCache(t *testing.T) {
+	c := &previewCache{}
+	n := &note.Note{Content: "第一版"}
+	assert.Contains(t, ansi.Strip(c.render(n, "dark", 40)), "第一版")
+
+	// AI 心智註解: 同一則筆記與寬度直接使用快取；重新載入會產生新的筆記指標而重新渲染。
+	n.Content = "第二版"
+	assert.Contains(t, ansi.Strip(c.render(n, "dark", 40)), "第一版")
+	assert.Contains(t, ansi.Strip(c.render(n, "dark", 50)), "第二版")
+	assert.Contains(t, ansi.Strip(c.render(&note.Note{Content: "第三版"}, "dark", 50)), "第三版")
+}
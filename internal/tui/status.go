@@ -0,0 +1,144 @@
+// Package tui 提供了終端使用者介面 (TUI) 的實現。
+package tui
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+)
+
+// statusLevel 是狀態列訊息的層級。
+type statusLevel int
+
+const (
+	statusInfo    statusLevel = iota // 一般資訊，例如儲存成功。
+	statusWarning                    // 警告，操作仍可繼續，例如配置錯誤而改用預設值。
+	statusError                      // 錯誤，操作失敗但保留目前的視圖與輸入內容。
+)
+
+// errorLogSize 是錯誤紀錄保留的最大筆數。
+const errorLogSize = 50
+
+// statusDurations 是各層級訊息在狀態列停留的時間。
+var statusDurations = map[statusLevel]time.Duration{
+	statusInfo:    3 * time.Second,
+	statusWarning: 5 * time.Second,
+	statusError:   8 * time.Second,
+}
+
+// statusLabelKeys 是各層級訊息前綴的訊息鍵，一般訊息沒有前綴。
+var statusLabelKeys = map[statusLevel]string{
+	statusWarning: "status.warning",
+	statusError:   "status.error",
+}
+
+// statusLabel 返回訊息層級的前綴。
+func statusLabel(level statusLevel) string {
+	key, ok := statusLabelKeys[level]
+	if !ok {
+		return ""
+	}
+	return i18n.T(key)
+}
+
+// statusMessage 是顯示在狀態列或錯誤紀錄中的一則訊息。
+type statusMessage struct {
+	id    int         // 訊息序號，用於判斷自動清除是否仍對應目前的訊息。
+	level statusLevel // 訊息層級。
+	text  string      // 訊息內容。
+	at    time.Time   // 發生時間。
+}
+
+// clearStatusMsg 訊息表示狀態列訊息的顯示時間已到。
+type clearStatusMsg struct {
+	id int
+}
+
+// setStatus 在狀態列顯示訊息，返回到期後清除訊息的指令；警告與錯誤同時記錄到錯誤紀錄。
+func (m *model) setStatus(level statusLevel, format string, args ...any) tea.Cmd {
+	m.statusSeq++
+	s := statusMessage{id: m.statusSeq, level: level, text: fmt.Sprintf(format, args...), at: time.Now()}
+	m.status = &s
+	if level != statusInfo {
+		// AI 心智註解: model 以值傳遞，先複製再附加，避免舊的 model 副本與新副本共用底層陣列而互相覆寫。
+		m.errorLog = append(slices.Clone(m.errorLog), s)
+		if len(m.errorLog) > errorLogSize {
+			m.errorLog = m.errorLog[len(m.errorLog)-errorLogSize:]
+		}
+	}
+	// AI 心智註解: 建立視圖的歷史區域高度取決於底部列，狀態列出現或消失時都要重新分配。
+	m.layout()
+	id := s.id
+	return tea.Tick(statusDurations[level], func(time.Time) tea.Msg { return clearStatusMsg{id: id} })
+}
+
+// clearStatus 在訊息到期時清除狀態列；較新的訊息不受舊的到期訊息影響。
+func (m *model) clearStatus(id int) {
+	if m.status != nil && m.status.id == id {
+		m.status = nil
+		m.layout()
+	}
+}
+
+// statusView 渲染狀態列，沒有訊息時返回空字串。
+func (m model) statusView() string {
+	if m.status == nil {
+		return ""
+	}
+	return m.styles.status[m.status.level].Render(statusLabel(m.status.level) + m.status.text)
+}
+
+// footerView 渲染狀態列與說明列。
+func (m model) footerView() string {
+	if s := m.statusView(); s != "" {
+		return s + "\n" + m.helpView()
+	}
+	return m.helpView()
+}
+
+// openErrorLog 切換到錯誤紀錄視圖，返回時回到目前的視圖。
+func (m model) openErrorLog() model {
+	m.prevView = m.currentView
+	m.currentView = errorLogView
+	return m
+}
+
+// updateErrorLog 處理錯誤紀錄視圖的按鍵。
+func (m model) updateErrorLog(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Quit):
+		return m, tea.Quit
+	case key.Matches(msg, m.keys.Help):
+		m.help.ShowAll = !m.help.ShowAll
+	case key.Matches(msg, m.keys.Back), key.Matches(msg, m.keys.ErrorLog):
+		m.currentView = m.prevView
+	}
+	return m, nil
+}
+
+// errorLogViewString 渲染最近的警告與錯誤，最新的在最上方。
+func (m model) errorLogViewString() string {
+	var b strings.Builder
+	b.WriteString(m.styles.header.Render(i18n.T("status.log_header")) + "\n\n")
+	if len(m.errorLog) == 0 {
+		b.WriteString(i18n.T("status.log_empty") + "\n")
+	}
+	help := m.helpView()
+	// AI 心智註解: 已知終端高度時只顯示放得下的最新幾筆，避免說明列被擠出畫面。
+	oldest := 0
+	if m.height > 0 {
+		oldest = max(len(m.errorLog)-(m.height-4-lipgloss.Height(help)), 0)
+	}
+	for i := len(m.errorLog) - 1; i >= oldest; i-- {
+		s := m.errorLog[i]
+		b.WriteString(fmt.Sprintf("%s %s\n", s.at.Format("15:04:05"), m.styles.status[s.level].Render(statusLabel(s.level)+s.text)))
+	}
+	b.WriteString("\n" + help + "\n")
+	return b.String()
+}
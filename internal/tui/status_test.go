@@ -0,0 +1,103 @@
+package tui
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStatus_AutoDismiss 測試狀態列訊息到期後清除，且舊訊息的到期不影響較新的訊息。
+func TestStatus_AutoDismiss(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	m := loadedModel()
+	m.setStatus(statusInfo, "第一則")
+	first := m.status.id
+	m.setStatus(statusWarning, "第二則")
+	assert.Contains(t, m.View(), "警告: 第二則")
+
+	m = update(m, clearStatusMsg{id: first})
+	assert.Contains(t, m.View(), "第二則")
+	m = update(m, clearStatusMsg{id: m.status.id})
+	assert.Nil(t, m.status)
+	assert.NotContains(t, m.View(), "第二則")
+
+	// AI 心智註解: 只有警告與錯誤會留在錯誤紀錄中。
+	require.Len(t, m.errorLog, 1)
+	assert.Equal(t, "第二則", m.errorLog[0].text)
+}
+
+// TestStatus_SaveErrorKeepsComposer 測試儲存失敗時停留在建立視圖並保留表單內容。
+func TestStatus_SaveErrorKeepsComposer(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	m := fillFormFields(openForm(t, loadedModel()), "標題", "work", "內容")
+	// AI 心智註解: 表單會在送出前擋下非法字元，因此直接送出儲存失敗的結果。
+	m.saving++
+	m = update(m, noteSavedMsg{err: errors.New("磁碟已滿")})
+
+	assert.Equal(t, createView, m.currentView)
+	assert.Equal(t, "標題", m.titleInput.Value())
+	assert.Equal(t, "work", m.tagsInput.Value())
+	assert.Equal(t, "內容", m.inputArea.Text())
+	assert.Contains(t, m.View(), "錯誤: 儲存筆記失敗")
+	require.Len(t, m.errorLog, 1)
+	assert.Contains(t, m.errorLog[0].text, "磁碟已滿")
+}
+
+// TestStatus_ErrorLogView 測試錯誤紀錄視圖列出最近的錯誤（最新在前），並返回原本的視圖。
+func TestStatus_ErrorLogView(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	m := loadedModel()
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'!'}})
+	require.Equal(t, errorLogView, m.currentView)
+	assert.Contains(t, m.View(), "沒有錯誤紀錄")
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEsc})
+	require.Equal(t, listView, m.currentView)
+
+	m.setStatus(statusError, "舊的錯誤")
+	m.setStatus(statusError, "新的錯誤")
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}})
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'!'}})
+	view := m.View()
+	assert.Less(t, strings.Index(view, "新的錯誤"), strings.Index(view, "舊的錯誤"))
+	m = pressKey(m, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, tasksView, m.currentView)
+}
+
+// TestStatus_ErrorLogLimit 測試錯誤紀錄只保留最近的 errorLogSize 筆。
+func TestStatus_ErrorLogLimit(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	m := loadedModel()
+	for i := 0; i < errorLogSize+5; i++ {
+		m.setStatus(statusError, "錯誤 %d", i)
+	}
+	require.Len(t, m.errorLog, errorLogSize)
+	assert.Equal(t, "錯誤 5", m.errorLog[0].text)
+}
+
+// TestStatus_ErrorLogNotShared 測試 model 副本各自附加錯誤紀錄時不會覆寫彼此的內容。
+func TestStatus_ErrorLogNotShared(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	m := loadedModel()
+	m.setStatus(statusError, "共同")
+	m.errorLog = slices.Grow(m.errorLog, 4)
+	a, b := m, m
+	a.setStatus(statusError, "甲")
+	b.setStatus(statusError, "乙")
+	assert.Equal(t, "甲", a.errorLog[len(a.errorLog)-1].text)
+	assert.Equal(t, "乙", b.errorLog[len(b.errorLog)-1].text)
+}
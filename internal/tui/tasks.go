@@ -0,0 +1,62 @@
+// Package tui 提供了終端使用者介面 (TUI) 的實現。
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+	"github.com/wtg42/ora-ora-ora/internal/task"
+)
+
+// openTasksView 切換至待辦事項視圖，並在背景載入未完成項目。
+func (m model) openTasksView() (tea.Model, tea.Cmd) {
+	m.tasks = nil
+	m.taskCursor = 0
+	m.currentView = tasksView
+	return m, m.loadTasks()
+}
+
+// toggleSelectedTask 在背景勾選目前選中的待辦項目並寫回來源筆記，完成後重新載入。
+func (m model) toggleSelectedTask() (tea.Model, tea.Cmd) {
+	if len(m.tasks) == 0 {
+		return m, nil
+	}
+	return m, m.toggleTask(m.tasks[m.taskCursor])
+}
+
+// tasksViewString 渲染依筆記分組的未完成待辦項目。
+func (m model) tasksViewString() string {
+	var b strings.Builder
+	b.WriteString(m.styles.header.Render(i18n.T("tasks.header")) + m.busyIndicator() + "\n")
+
+	if len(m.tasks) == 0 && m.loading == "" {
+		b.WriteString("\n" + i18n.T("tasks.empty") + "\n")
+	}
+	currentNote := ""
+	for i, t := range m.tasks {
+		// AI 心智註解: 項目已依筆記順序排列，筆記切換時輸出分組標題即可。
+		if t.NoteID != currentNote {
+			currentNote = t.NoteID
+			b.WriteString(fmt.Sprintf("\n%s\n", t.NoteTitle))
+		}
+		cursor := " "
+		if m.taskCursor == i {
+			cursor = m.styles.cursor.Render(">")
+		}
+		line := fmt.Sprintf("%s [ ] %s", cursor, t.Text)
+		if !t.Due.IsZero() {
+			line += " due:" + t.Due.Format(task.DateLayout)
+		}
+		for _, p := range t.People {
+			line += " @" + p
+		}
+		if t.Priority != task.PriorityNone {
+			line += " " + t.Priority.String()
+		}
+		b.WriteString(line + "\n")
+	}
+	b.WriteString("\n" + m.footerView() + "\n")
+	return b.String()
+}
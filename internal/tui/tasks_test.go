@@ -0,0 +1,81 @@
+// Package tui 提供了待辦事項視圖的單元測試。
+package tui
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtg42/ora-ora-ora/internal/note"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+)
+
+// TestTasksView_ListAndToggle 測試待辦事項視圖的分組顯示與勾選流程。
+func TestTasksView_ListAndToggle(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	n := &note.Note{
+		Title:     "Plan",
+		Content:   "- [ ] first @alice\n- [x] done\n- [ ] second !high",
+		CreatedAt: time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, storage.SaveNote(n))
+
+	m := loadedModel()
+	m = update(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}})
+
+	require.Equal(t, tasksView, m.currentView)
+	require.Len(t, m.tasks, 2)
+	view := m.View()
+	assert.Contains(t, view, "Plan")
+	assert.Contains(t, view, "> [ ] first @alice")
+	assert.Contains(t, view, "second !high")
+
+	// 移到第二項並勾選，該項目應從列表中消失並寫回檔案。
+	updatedModel, _ := m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m = updatedModel.(model)
+	m = update(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+
+	require.Empty(t, m.errorLog)
+	require.Len(t, m.tasks, 1)
+	assert.Equal(t, 0, m.taskCursor)
+
+	contentBytes, err := os.ReadFile(n.Path)
+	require.NoError(t, err)
+	assert.Contains(t, string(contentBytes), "- [x] second !high")
+
+	// 按下 Esc 返回列表視圖。
+	updatedModel, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updatedModel.(model)
+	assert.Equal(t, listView, m.currentView)
+}
+
+// TestTasksView_ToggleChangedNote 測試載入待辦事項後筆記被外部修改時不勾選，並記錄錯誤。
+func TestTasksView_ToggleChangedNote(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	n := &note.Note{
+		Title:     "Plan",
+		Content:   "- [ ] first\n- [ ] second",
+		CreatedAt: time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC),
+	}
+	require.NoError(t, storage.SaveNote(n))
+
+	m := update(loadedModel(), tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}})
+	require.Len(t, m.tasks, 2)
+
+	n.Content = "- [ ] inserted\n- [ ] first\n- [ ] second"
+	require.NoError(t, storage.UpdateNote(n))
+	m = update(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+
+	require.Len(t, m.errorLog, 1)
+	assert.Contains(t, m.errorLog[0].text, "第 1 行在列出後已被修改")
+	contentBytes, err := os.ReadFile(n.Path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(contentBytes), "[x]")
+}
@@ -0,0 +1,209 @@
+// Package tui 提供了終端使用者介面 (TUI) 的實現。
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	glamourstyles "github.com/charmbracelet/glamour/styles"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/wtg42/ora-ora-ora/internal/config"
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+)
+
+// 內建主題名稱，對應配置檔案中的 theme。
+const (
+	themeAuto         = "auto"          // 依終端背景在淺色與深色之間切換（預設）。
+	themeDark         = "dark"          // 深色背景。
+	themeLight        = "light"         // 淺色背景。
+	themeHighContrast = "high-contrast" // 高對比，依終端背景選用最亮或最暗的顏色並加粗。
+)
+
+// palette 是主題在單一背景下的顏色與 Markdown 樣式，顏色為空字串時不設定顏色。
+type palette struct {
+	accent     string // 標題列、使用者訊息與取得焦點的欄位。
+	success    string // 系統回應與一般狀態訊息。
+	warning    string // 警告。
+	errorColor string // 錯誤與驗證訊息。
+	match      string // 篩選時符合的字元。
+	muted      string // 框線、日期與標籤等次要文字。
+	bold       bool   // 警告與錯誤是否加粗。
+	markdown   string // glamour 的標準樣式名稱。
+}
+
+// theme 是一組淺色與深色背景的 palette，渲染時依終端背景選用。
+type theme struct {
+	light, dark palette
+}
+
+var (
+	darkPalette  = palette{accent: "12", success: "10", warning: "11", errorColor: "9", match: "205", muted: "8", markdown: glamourstyles.DarkStyle}
+	lightPalette = palette{accent: "4", success: "2", warning: "130", errorColor: "1", match: "162", muted: "243", markdown: glamourstyles.LightStyle}
+)
+
+// builtinThemes 是內建的主題。
+var builtinThemes = map[string]theme{
+	themeAuto:  {light: lightPalette, dark: darkPalette},
+	themeDark:  {light: darkPalette, dark: darkPalette},
+	themeLight: {light: lightPalette, dark: lightPalette},
+	themeHighContrast: {
+		light: palette{accent: "18", success: "22", warning: "94", errorColor: "88", match: "90", muted: "0", bold: true, markdown: glamourstyles.LightStyle},
+		dark:  palette{accent: "14", success: "10", warning: "11", errorColor: "9", match: "13", muted: "15", bold: true, markdown: glamourstyles.DarkStyle},
+	},
+}
+
+// noColorTheme 是設定 NO_COLOR 時使用的主題：不輸出任何顏色，只以粗體與淡化區分文字。
+var noColorTheme = theme{
+	light: palette{bold: true, markdown: glamourstyles.NoTTYStyle},
+	dark:  palette{bold: true, markdown: glamourstyles.NoTTYStyle},
+}
+
+// colorPattern 匹配 ANSI 色號或 #RGB、#RRGGBB 格式的顏色。
+var colorPattern = regexp.MustCompile(`^(\d{1,3}|#[0-9a-fA-F]{3}|#[0-9a-fA-F]{6})$`)
+
+// validateColor 檢查自訂主題中的顏色格式。
+func validateColor(field, value string) error {
+	if !colorPattern.MatchString(value) {
+		return errors.New(i18n.T("theme.invalid_color", field, value))
+	}
+	if n, err := strconv.Atoi(value); err == nil && n > 255 {
+		return errors.New(i18n.T("theme.color_out_of_range", field, value))
+	}
+	return nil
+}
+
+// resolveTheme 依名稱返回內建主題或配置檔案中的自訂主題；自訂主題以 base 的內建主題為基礎覆蓋顏色。
+func resolveTheme(name string, custom map[string]config.Theme) (theme, error) {
+	if name == "" {
+		name = themeAuto
+	}
+	if t, ok := builtinThemes[name]; ok {
+		return t, nil
+	}
+	c, ok := custom[name]
+	if !ok {
+		return theme{}, errors.New(i18n.T("theme.unknown", name, strings.Join(builtinThemeNames(), i18n.T("list.separator"))))
+	}
+	base := c.Base
+	if base == "" {
+		base = themeAuto
+	}
+	t, ok := builtinThemes[base]
+	if !ok {
+		return theme{}, errors.New(i18n.T("theme.invalid_base", name, base, strings.Join(builtinThemeNames(), i18n.T("list.separator"))))
+	}
+	// AI 心智註解: 自訂的顏色同時套用到淺色與深色背景，未設定的欄位仍依終端背景選用 base 的顏色。
+	overrides := []struct {
+		field string
+		value string
+		set   func(p *palette, v string)
+	}{
+		{"accent", c.Accent, func(p *palette, v string) { p.accent = v }},
+		{"success", c.Success, func(p *palette, v string) { p.success = v }},
+		{"warning", c.Warning, func(p *palette, v string) { p.warning = v }},
+		{"error", c.Error, func(p *palette, v string) { p.errorColor = v }},
+		{"match", c.Match, func(p *palette, v string) { p.match = v }},
+		{"muted", c.Muted, func(p *palette, v string) { p.muted = v }},
+	}
+	for _, o := range overrides {
+		if o.value == "" {
+			continue
+		}
+		if err := validateColor(o.field, o.value); err != nil {
+			return theme{}, fmt.Errorf(i18n.T("theme.error"), name, err)
+		}
+		o.set(&t.light, o.value)
+		o.set(&t.dark, o.value)
+	}
+	if c.Markdown != "" {
+		if _, ok := glamourstyles.DefaultStyles[c.Markdown]; !ok {
+			return theme{}, errors.New(i18n.T("theme.unknown_markdown", name, c.Markdown))
+		}
+		t.light.markdown, t.dark.markdown = c.Markdown, c.Markdown
+	}
+	return t, nil
+}
+
+// builtinThemeNames 返回依名稱排序的內建主題名稱。
+func builtinThemeNames() []string {
+	names := make([]string, 0, len(builtinThemes))
+	for name := range builtinThemes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// styles 是依主題產生、套用到各視圖的樣式。
+type styles struct {
+	header             lipgloss.Style                 // 各視圖的標題列。
+	history            map[role]lipgloss.Style        // 歷史對話區域中每種訊息來源的樣式。
+	status             map[statusLevel]lipgloss.Style // 狀態列與錯誤紀錄中每個層級的樣式。
+	match              lipgloss.Style                 // 標題中符合模糊搜尋的字元。
+	muted              lipgloss.Style                 // 日期、標籤等次要文字。
+	cursor             lipgloss.Style                 // 列表中選中項目的游標記號。
+	previewBorder      lipgloss.Style                 // 右側預覽窗格的左框線。
+	focusedPrompt      lipgloss.Style                 // 表單中取得焦點的欄位提示。
+	blurredPrompt      lipgloss.Style                 // 表單中其他欄位的提示。
+	fieldError         lipgloss.Style                 // 欄位下方的驗證錯誤。
+	suggestion         lipgloss.Style                 // 標籤補全候選。
+	selectedSuggestion lipgloss.Style                 // 選中的標籤補全候選。
+	inputCursor        lipgloss.Style                 // 內容欄位的游標。
+	markdown           string                         // glamour 渲染筆記使用的標準樣式。
+}
+
+// newStyles 依主題產生樣式；顏色隨終端背景自動切換，dark 表示終端背景為深色，用於選擇 Markdown 樣式與是否加粗。
+func newStyles(t theme, dark bool) styles {
+	p := t.light
+	if dark {
+		p = t.dark
+	}
+	color := func(pick func(palette) string) lipgloss.TerminalColor {
+		return lipgloss.AdaptiveColor{Light: pick(t.light), Dark: pick(t.dark)}
+	}
+	fg := func(pick func(palette) string) lipgloss.Style {
+		return lipgloss.NewStyle().Foreground(color(pick))
+	}
+	accent := fg(func(p palette) string { return p.accent })
+	success := fg(func(p palette) string { return p.success })
+	warning := fg(func(p palette) string { return p.warning })
+	errorText := fg(func(p palette) string { return p.errorColor })
+	// AI 心智註解: 沒有設定次要文字的顏色時（例如 NO_COLOR）改以淡化區分。
+	muted := fg(func(p palette) string { return p.muted }).Faint(p.muted == "")
+	return styles{
+		header:             accent.Bold(true),
+		history:            map[role]lipgloss.Style{roleUser: accent, roleSystem: success, roleError: errorText},
+		status:             map[statusLevel]lipgloss.Style{statusInfo: success, statusWarning: warning.Bold(p.bold), statusError: errorText.Bold(p.bold)},
+		match:              fg(func(p palette) string { return p.match }).Bold(true).Underline(p.match == ""),
+		muted:              muted,
+		cursor:             accent.Bold(true),
+		previewBorder:      lipgloss.NewStyle().BorderStyle(lipgloss.NormalBorder()).BorderLeft(true).BorderForeground(color(func(p palette) string { return p.muted })).PaddingLeft(1),
+		focusedPrompt:      accent.Bold(true),
+		blurredPrompt:      muted,
+		fieldError:         errorText.Bold(p.bold),
+		suggestion:         muted,
+		selectedSuggestion: lipgloss.NewStyle().Reverse(true),
+		inputCursor:        lipgloss.NewStyle().Reverse(true),
+		markdown:           p.markdown,
+	}
+}
+
+// loadStyles 依配置檔案的主題設定產生樣式；設定了 NO_COLOR 環境變數時一律不使用顏色。
+// 主題設定有誤時改用 auto 主題並返回錯誤，由呼叫端顯示警告。
+func loadStyles(name string, custom map[string]config.Theme) (styles, error) {
+	dark := lipgloss.HasDarkBackground()
+	// AI 心智註解: 依 https://no-color.org，NO_COLOR 只要有值（不論內容）就停用顏色。
+	if os.Getenv("NO_COLOR") != "" {
+		return newStyles(noColorTheme, dark), nil
+	}
+	t, err := resolveTheme(name, custom)
+	if err != nil {
+		return newStyles(builtinThemes[themeAuto], dark), err
+	}
+	return newStyles(t, dark), nil
+}
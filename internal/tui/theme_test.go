@@ -0,0 +1,103 @@
+// Package tui 提供了 TUI 主題的單元測試。
+package tui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtg42/ora-ora-ora/internal/config"
+)
+
+// TestResolveTheme_Builtin 測試內建主題名稱與預設值。
+func TestResolveTheme_Builtin(t *testing.T) {
+	for _, name := range builtinThemeNames() {
+		th, err := resolveTheme(name, nil)
+		require.NoError(t, err, name)
+		assert.Equal(t, builtinThemes[name], th)
+	}
+	th, err := resolveTheme("", nil)
+	require.NoError(t, err)
+	assert.Equal(t, builtinThemes[themeAuto], th, "未設定時使用 auto")
+}
+
+// TestResolveTheme_Custom 測試自訂主題以 base 為基礎覆蓋指定的顏色。
+func TestResolveTheme_Custom(t *testing.T) {
+	custom := map[string]config.Theme{
+		"solar": {Base: themeLight, Accent: "#268bd2", Error: "160", Markdown: "dracula"},
+	}
+	th, err := resolveTheme("solar", custom)
+	require.NoError(t, err)
+	for _, p := range []palette{th.light, th.dark} {
+		assert.Equal(t, "#268bd2", p.accent)
+		assert.Equal(t, "160", p.errorColor)
+		assert.Equal(t, lightPalette.success, p.success, "未設定的顏色沿用 base")
+		assert.Equal(t, "dracula", p.markdown)
+	}
+
+	th, err = resolveTheme("plain", map[string]config.Theme{"plain": {Match: "13"}})
+	require.NoError(t, err)
+	assert.Equal(t, darkPalette.accent, th.dark.accent, "沒有 base 時以 auto 為基礎")
+	assert.Equal(t, lightPalette.accent, th.light.accent)
+	assert.Equal(t, "13", th.light.match)
+}
+
+// TestResolveTheme_Errors 測試無效的主題設定返回錯誤。
+func TestResolveTheme_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		custom config.Theme
+		want   string
+	}{
+		{"bad-base", config.Theme{Base: "solar"}, "不是內建主題"},
+		{"bad-color", config.Theme{Accent: "blue"}, "accent 的顏色"},
+		{"out-of-range", config.Theme{Muted: "300"}, "0 到 255"},
+		{"bad-markdown", config.Theme{Markdown: "neon"}, "markdown 樣式"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := resolveTheme(tt.name, map[string]config.Theme{tt.name: tt.custom})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+
+	_, err := resolveTheme("nope", nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "未知的主題")
+}
+
+// TestNewStyles_Adaptive 測試樣式的顏色依終端背景在淺色與深色之間切換。
+func TestNewStyles_Adaptive(t *testing.T) {
+	s := newStyles(builtinThemes[themeAuto], true)
+	assert.Equal(t, lipgloss.AdaptiveColor{Light: lightPalette.accent, Dark: darkPalette.accent}, s.header.GetForeground())
+	assert.Equal(t, darkPalette.markdown, s.markdown)
+	assert.Equal(t, lightPalette.markdown, newStyles(builtinThemes[themeAuto], false).markdown)
+
+	hc := newStyles(builtinThemes[themeHighContrast], true)
+	assert.True(t, hc.status[statusError].GetBold(), "高對比主題加粗錯誤訊息")
+	assert.False(t, s.status[statusError].GetBold())
+}
+
+// TestLoadStyles_NoColor 測試設定 NO_COLOR 時不使用顏色，即使配置了主題。
+func TestLoadStyles_NoColor(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	s, err := loadStyles(themeHighContrast, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "notty", s.markdown)
+	assert.Equal(t, lipgloss.AdaptiveColor{}, s.header.GetForeground())
+	assert.True(t, s.muted.GetFaint(), "沒有顏色時以淡化區分次要文字")
+}
+
+// TestInitialModel_InvalidTheme 測試無效的主題設定顯示配置警告，並改用 auto 主題繼續執行。
+func TestInitialModel_InvalidTheme(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	setupTestConfig(t, "theme = \"nope\"\n")
+
+	m := loadedModel()
+	assert.Contains(t, m.statusView(), "主題配置無效")
+	require.NotEmpty(t, m.errorLog)
+	assert.Contains(t, m.errorLog[0].text, "未知的主題")
+}
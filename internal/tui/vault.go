@@ -0,0 +1,55 @@
+// Package tui 提供了終端使用者介面 (TUI) 的實現。
+package tui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/wtg42/ora-ora-ora/internal/i18n"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+)
+
+// vaultCommands 返回命令面板中切換筆記庫的動作，每個未選用的筆記庫一項。
+// 配置檔案定義了筆記庫時，預設的資料目錄也列為可切換的對象；沒有定義時不返回任何動作。
+func (m model) vaultCommands() []command {
+	if len(m.vaults) == 0 {
+		return nil
+	}
+	current := storage.CurrentVault()
+	var cmds []command
+	for _, name := range append([]string{""}, m.vaults.Names()...) {
+		if name == current {
+			continue
+		}
+		cmds = append(cmds, command{id: "vault", title: i18n.T("command.vault", vaultLabel(name)), column: columnApp,
+			run: func(m model) (tea.Model, tea.Cmd) { return m.switchVault(name) }})
+	}
+	return cmds
+}
+
+// vaultLabel 返回筆記庫顯示的名稱，預設的資料目錄顯示為「預設」。
+func vaultLabel(name string) string {
+	if name == "" {
+		return i18n.T("command.vault_default")
+	}
+	return name
+}
+
+// switchVault 切換到名稱為 name 的筆記庫：取消進行中的讀取與內容搜尋，清除選取與篩選，並重新載入筆記。
+// 儲存進行中時不切換，避免筆記寫入切換後的筆記庫。
+func (m model) switchVault(name string) (tea.Model, tea.Cmd) {
+	if m.saving > 0 {
+		return m, m.setStatus(statusWarning, "%s", i18n.T("command.vault_busy"))
+	}
+	dir, ok := m.vaults.Dir(name)
+	if !ok {
+		return m, nil
+	}
+	m.cancelLoading()
+	m.searchContent("")
+	m = m.clearSelection()
+	m = m.clearFilter()
+	storage.SetVault(name, dir)
+	// AI 心智註解: 清空列表後重新讀取，讀取期間顯示讀取中而不是前一個筆記庫的筆記。
+	m.notes, m.items, m.cursor, m.selectedPath = nil, nil, 0, ""
+	m.notesLoaded = false
+	return m, tea.Batch(m.loadNotes(), m.setStatus(statusInfo, i18n.T("command.vault_switched"), vaultLabel(name)))
+}
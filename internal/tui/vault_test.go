@@ -0,0 +1,99 @@
+package tui
+
+import (
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wtg42/ora-ora-ora/internal/storage"
+)
+
+// setupTestVaults 設定名為 work 的筆記庫並在預設資料目錄與 work 中各寫入一則筆記，返回 work 的目錄。
+func setupTestVaults(t *testing.T) string {
+	t.Helper()
+	dir := filepath.Join(t.TempDir(), "work")
+	setupTestConfig(t, "[vaults]\nwork = "+strconv.Quote(dir)+"\n")
+	t.Cleanup(func() { storage.SetVault("", "") })
+	require.NoError(t, writeTestNote("Home", "預設筆記庫"))
+	storage.SetVault("work", dir)
+	require.NoError(t, writeTestNote("Work", "work 筆記庫"))
+	storage.SetVault("", "")
+	return dir
+}
+
+// TestSwitchVault 測試從命令面板切換筆記庫後清除篩選並重新載入筆記，也能切換回預設的資料目錄。
+func TestSwitchVault(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	setupTestVaults(t)
+
+	m := loadedModel()
+	require.Equal(t, []string{"Home"}, itemTitles(m.items))
+	m = typeFilter(t, m, "Ho")
+	m = update(m, tea.KeyMsg{Type: tea.KeyEnter})
+
+	m = openTestPalette(t, m, "筆記庫 work")
+	require.NotEmpty(t, m.paletteItems)
+	require.NotNil(t, m.paletteItems[0].command)
+	assert.Equal(t, "切換到筆記庫 work", m.paletteItems[0].title())
+	m = update(m, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, listView, m.currentView)
+	assert.Equal(t, "work", storage.CurrentVault())
+	assert.Empty(t, m.filterInput.Value())
+	assert.Equal(t, []string{"Work"}, itemTitles(m.items))
+	assert.Equal(t, "已切換到筆記庫 work", m.status.text)
+	assert.Contains(t, m.View(), "筆記庫 work")
+
+	// 目前的筆記庫不列在命令面板中，預設的資料目錄則可切換回去。
+	m = openTestPalette(t, m, "筆記庫")
+	titles := paletteTitles(m.paletteItems)
+	assert.NotContains(t, titles, "切換到筆記庫 work")
+	require.Contains(t, titles, "切換到筆記庫 預設")
+	m = update(m, tea.KeyMsg{Type: tea.KeyEsc})
+	next, cmd := m.switchVault("")
+	m = runCmd(next.(model), cmd)
+	assert.Empty(t, storage.CurrentVault())
+	assert.Equal(t, []string{"Home"}, itemTitles(m.items))
+}
+
+// TestSwitchVault_CancelsLoad 測試切換筆記庫時取消進行中的讀取，前一個筆記庫的結果抵達時被丟棄。
+func TestSwitchVault_CancelsLoad(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	setupTestVaults(t)
+
+	m := loadedModel()
+	stale := m.loadNotes()
+	next, cmd := m.switchVault("work")
+	m = runCmd(next.(model), cmd)
+	require.Equal(t, []string{"Work"}, itemTitles(m.items))
+
+	m = runCmd(m, stale)
+	assert.Equal(t, []string{"Work"}, itemTitles(m.items))
+}
+
+// TestSwitchVault_WhileSaving 測試儲存進行中時不切換筆記庫，避免筆記寫入切換後的筆記庫。
+func TestSwitchVault_WhileSaving(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+	setupTestVaults(t)
+
+	m := loadedModel()
+	m.saving = 1
+	next, _ := m.switchVault("work")
+	m = next.(model)
+	assert.Empty(t, storage.CurrentVault())
+	assert.Equal(t, statusWarning, m.status.level)
+	assert.Equal(t, []string{"Home"}, itemTitles(m.items))
+}
+
+// TestVaultCommands_NoVaults 測試配置檔案沒有定義筆記庫時命令面板不提供切換筆記庫。
+func TestVaultCommands_NoVaults(t *testing.T) {
+	_, teardown := setupTestDataDir(t)
+	defer teardown()
+
+	assert.Empty(t, loadedModel().vaultCommands())
+}